@@ -4,6 +4,10 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"runtime"
+	"strings"
+
+	"github.com/example/sysinfo-cli/internal/system"
 )
 
 // Config holds CLI configuration from flags
@@ -18,6 +22,20 @@ type Config struct {
 	Limit        int
 	MountPoint   string
 	Color        string
+	Path         string
+	Workers      int
+	CrossMounts  bool
+	Forecast     bool
+	Threshold    float64
+	Snapshots    []string
+	Probe        bool
+	Iterations   int
+	Prefixes     []string
+	CompareFile  string
+	Names        []string
+	Scope        string
+	Depth        int
+	Socket       string
 }
 
 func parseFlags() Config {
@@ -28,21 +46,57 @@ func parseFlags() Config {
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON")
 	watch := fs.Bool("watch", false, "Watch mode (continuous updates)")
 	interval := fs.Int("interval", 1, "Watch interval in seconds (used with --watch)")
-	sortBy := fs.String("sort", "cpu", "Sort processes by: cpu or memory")
-	limit := fs.Int("limit", 10, "Number of top processes to display")
+	sortBy := fs.String("sort", "cpu", "Sort processes by: cpu or memory (cgroups also: io, pids, throttled, name)")
+	limit := fs.Int("limit", 10, "Number of top entries to display (process, du, interrupts)")
 	mount := fs.String("mount", "", "Filter disk by mount point")
 	color := fs.String("color", "auto", "Color output: auto, on, or off")
+	path := fs.String("path", ".", "Directory to scan (used with du)")
+	workers := fs.Int("workers", runtime.NumCPU(), "Number of concurrent scan workers (used with du)")
+	crossMounts := fs.Bool("cross-mounts", false, "Descend into other filesystems (used with du)")
+	forecast := fs.Bool("forecast", false, "Forecast time until each mount fills (used with disk)")
+	threshold := fs.Float64("threshold", 100, "Usage percent treated as full when forecasting")
+	snapshots := fs.String("snapshots", "", "Comma-separated saved disk JSON snapshots to forecast from")
+	probe := fs.Bool("probe", false, "Probe write/fsync/read/delete latency on --mount (used with disk)")
+	iterations := fs.Int("iterations", 20, "Number of probe iterations (used with --probe)")
+	prefix := fs.String("prefix", "", "Comma-separated sysctl prefixes, e.g. net.ipv4,vm (used with sysctl)")
+	name := fs.String("name", "", "Comma-separated name substrings to filter by (used with modules and docker)")
+	scope := fs.String("scope", "container", "Primary view for cpu and memory: container (cgroup limits) or host")
+	depth := fs.Int("depth", 0, "Maximum tree depth, 0 for unlimited (used with cgroups)")
+	socket := fs.String("socket", defaultDockerSocket(), "Docker Engine API unix socket (used with docker)")
+	compare := fs.String("compare", "", "Desired sysctl values file to compare against (used with sysctl)")
 
 	fs.Usage = func() {
 		fmt.Fprintf(os.Stderr, `Usage: sysinfo <command> [flags]
 
 Commands:
-  os        Display operating system information
-  cpu       Display CPU information and usage
-  memory    Display memory/RAM information
-  disk      Display disk/storage information
-  network   Display network interface information
-  process   Display top processes by CPU/memory
+  os          Display operating system information
+  cpu         Display CPU information and usage
+  memory      Display memory/RAM information
+  disk        Display disk/storage information
+  network     Display network interface information
+  process     Display top processes by CPU/memory
+  swap        Display swap devices, zram and zswap status
+  du          Display the largest directories and files under --path
+  pressure    Display pressure stall information (PSI) for cpu, memory and io
+  vmstat      Display paging, swapping, reclaim and OOM activity counters
+  stat        Display context switch, interrupt and fork counters
+  interrupts  Display the busiest IRQs and softirqs per CPU
+  numa        Display NUMA nodes, per-node memory and distances
+  hugepages   Display hugepage pools and transparent hugepage settings
+  limits      Display kernel table usage (files, pids, threads, inotify) against limits
+  sysctl      Display /proc/sys values, or compare them against --compare FILE
+  modules     Display loaded kernel modules with taint flags and versions
+  kernel      Display the kernel command line, boot time and decoded taint flags
+  time        Display timezone, NTP sync status, clocksource and RTC skew
+  cgroups     Display the cgroup v2 hierarchy with per-cgroup usage and limits
+  containers  Display containers found in cgroups with their processes, usage and limits
+  docker      Display containers and stats from the Docker Engine API socket
+  virt        Display whether sysinfo runs on bare metal, in a VM or in a container
+  hardware    Display the DMI inventory: system, board, BIOS and chassis
+  devices     Display PCI and USB devices with IDs, drivers, NUMA node and link speed
+  sensors     Display thermal zones, hwmon temperatures, fans, voltages and power
+  namespaces  Display namespaces of each type with member processes and owners
+  ipc         Display System V shared memory, semaphores, message queues and /dev/shm usage
 
 Flags:
 `)
@@ -71,13 +125,63 @@ Flags:
 		Limit:         *limit,
 		MountPoint:    *mount,
 		Color:         *color,
+		Path:          *path,
+		Workers:       *workers,
+		CrossMounts:   *crossMounts,
+		Forecast:      *forecast || *snapshots != "",
+		Threshold:     *threshold,
+		Snapshots:     splitList(*snapshots),
+		Probe:         *probe,
+		Iterations:    *iterations,
+		Prefixes:      splitList(*prefix),
+		CompareFile:   *compare,
+		Names:         splitList(*name),
+		Scope:         *scope,
+		Depth:         *depth,
+		Socket:        *socket,
+	}
+}
+
+// defaultDockerSocket honours a unix:// DOCKER_HOST, as the docker CLI does
+func defaultDockerSocket() string {
+	if host := os.Getenv("DOCKER_HOST"); strings.HasPrefix(host, "unix://") {
+		return strings.TrimPrefix(host, "unix://")
 	}
+	return system.DefaultDockerSocket
+}
+
+// splitList splits a comma-separated flag value, dropping empty items
+func splitList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func containsString(items []string, value string) bool {
+	for _, item := range items {
+		if item == value {
+			return true
+		}
+	}
+	return false
 }
 
 func (c Config) Validate() error {
 	validCommands := map[string]bool{
 		"os": true, "cpu": true, "memory": true,
 		"disk": true, "network": true, "process": true,
+		"swap": true, "du": true, "pressure": true,
+		"vmstat": true, "stat": true, "interrupts": true,
+		"numa": true, "hugepages": true, "limits": true,
+		"sysctl": true, "modules": true, "kernel": true,
+		"time": true, "cgroups": true, "containers": true,
+		"docker": true, "virt": true, "hardware": true,
+		"devices": true, "sensors": true, "namespaces": true,
+		"ipc": true,
 	}
 
 	if !validCommands[c.Command] {
@@ -92,8 +196,17 @@ func (c Config) Validate() error {
 		return fmt.Errorf("invalid format: %s", c.Format)
 	}
 
-	if c.SortBy != "cpu" && c.SortBy != "memory" {
-		return fmt.Errorf("invalid sort: %s (must be cpu or memory)", c.SortBy)
+	// Sort keys depend on the command; most only rank by cpu or memory
+	sortKeys := []string{"cpu", "memory"}
+	if c.Command == "cgroups" {
+		sortKeys = system.CgroupSortKeys
+	}
+	if !containsString(sortKeys, c.SortBy) {
+		return fmt.Errorf("invalid sort: %s (must be %s)", c.SortBy, strings.Join(sortKeys, ", "))
+	}
+
+	if c.Depth < 0 {
+		return fmt.Errorf("depth must be >= 0")
 	}
 
 	if c.Limit < 1 {
@@ -104,6 +217,30 @@ func (c Config) Validate() error {
 		return fmt.Errorf("interval must be >= 1")
 	}
 
+	if c.Command == "du" && c.Workers < 1 {
+		return fmt.Errorf("workers must be >= 1")
+	}
+
+	if c.Forecast && (c.Threshold <= 0 || c.Threshold > 100) {
+		return fmt.Errorf("threshold must be > 0 and <= 100")
+	}
+
+	if c.Probe {
+		if c.MountPoint == "" {
+			return fmt.Errorf("--probe requires --mount")
+		}
+		if c.Forecast {
+			return fmt.Errorf("--probe cannot be combined with --forecast")
+		}
+		if c.Iterations < 1 {
+			return fmt.Errorf("iterations must be >= 1")
+		}
+	}
+
+	if (c.Command == "cpu" || c.Command == "memory") && c.Scope != "container" && c.Scope != "host" {
+		return fmt.Errorf("invalid scope: %s (must be container or host)", c.Scope)
+	}
+
 	validColors := map[string]bool{
 		"auto": true, "on": true, "off": true,
 	}
@@ -67,7 +67,7 @@ func TestValidateLimitTooLow(t *testing.T) {
 }
 
 func TestValidAllCommands(t *testing.T) {
-	commands := []string{"os", "cpu", "memory", "disk", "network", "process"}
+	commands := []string{"os", "cpu", "memory", "disk", "network", "process", "swap", "du", "pressure", "vmstat", "stat", "interrupts", "numa", "hugepages", "limits", "sysctl", "modules", "kernel", "time", "cgroups", "containers", "docker", "virt", "hardware", "devices", "sensors", "namespaces", "ipc"}
 
 	for _, cmd := range commands {
 		config := Config{
@@ -77,6 +77,8 @@ func TestValidAllCommands(t *testing.T) {
 			Limit:         10,
 			Color:         "auto",
 			WatchInterval: 1,
+			Workers:       1,
+			Scope:         "container",
 		}
 
 		if err := config.Validate(); err != nil {
@@ -103,3 +105,88 @@ func TestValidAllFormats(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateWorkersTooLow(t *testing.T) {
+	config := Config{
+		Command:       "du",
+		Format:        "json",
+		SortBy:        "cpu",
+		Limit:         10,
+		Color:         "auto",
+		WatchInterval: 1,
+		Workers:       0,
+	}
+
+	if err := config.Validate(); err == nil {
+		t.Errorf("Expected error for workers < 1")
+	}
+}
+
+func TestValidateProbeRequiresMount(t *testing.T) {
+	config := Config{
+		Command:       "disk",
+		Format:        "table",
+		SortBy:        "cpu",
+		Limit:         10,
+		Color:         "auto",
+		WatchInterval: 1,
+		Probe:         true,
+		Iterations:    5,
+	}
+
+	if err := config.Validate(); err == nil {
+		t.Errorf("Expected error for --probe without --mount")
+	}
+
+	config.MountPoint = "/tmp"
+	if err := config.Validate(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestValidateScope(t *testing.T) {
+	config := Config{
+		Command:       "memory",
+		Format:        "table",
+		SortBy:        "cpu",
+		Limit:         10,
+		Color:         "auto",
+		WatchInterval: 1,
+		Scope:         "pod",
+	}
+
+	if err := config.Validate(); err == nil {
+		t.Errorf("Expected error for invalid scope")
+	}
+
+	config.Scope = "host"
+	if err := config.Validate(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestValidateCgroupsSort(t *testing.T) {
+	config := Config{
+		Command:       "cgroups",
+		Format:        "table",
+		SortBy:        "throttled",
+		Limit:         10,
+		Color:         "auto",
+		WatchInterval: 1,
+	}
+
+	if err := config.Validate(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	config.Command = "process"
+	if err := config.Validate(); err == nil {
+		t.Errorf("Expected throttled to be rejected for process")
+	}
+
+	config.Command = "cgroups"
+	config.Depth = -1
+	if err := config.Validate(); err == nil {
+		t.Errorf("Expected error for negative depth")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/example/sysinfo-cli/internal/models"
 	"github.com/example/sysinfo-cli/internal/output"
 	"github.com/example/sysinfo-cli/internal/system"
 )
@@ -17,10 +18,20 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Disk samples collected for --forecast, seeded from saved snapshots
+	diskSamples, err := system.LoadDiskSnapshots(config.Snapshots)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Run once, or loop if watch mode
 	ticker := time.NewTicker(time.Duration(config.WatchInterval) * time.Second)
 	defer ticker.Stop()
 
+	// Previous sample, used by collectors that report rates in watch mode
+	var last interface{}
+
 	for {
 		var (
 			data interface{}
@@ -31,15 +42,78 @@ func main() {
 		case "os":
 			data, err = system.GetOSInfo()
 		case "cpu":
-			data, err = system.GetCPUInfo()
+			data, err = system.GetCPUInfo(config.Scope)
 		case "memory":
-			data, err = system.GetMemoryInfo()
+			data, err = system.GetMemoryInfo(config.Scope)
 		case "disk":
-			data, err = system.GetDiskInfo(config.MountPoint)
+			if config.Probe {
+				data, err = system.ProbeDisk(config.MountPoint, config.Iterations)
+				break
+			}
+			var disks []models.DiskInfo
+			disks, err = system.GetDiskInfo(config.MountPoint)
+			data = disks
+			if err == nil && config.Forecast {
+				diskSamples = system.AppendDiskSample(diskSamples, system.DiskSample{Time: time.Now(), Disks: disks})
+				data = system.ForecastDisks(diskSamples, config.Threshold)
+			}
 		case "network":
 			data, err = system.GetNetworkInfo()
 		case "process":
 			data, err = system.GetProcessInfo(config.SortBy, config.Limit)
+		case "swap":
+			data, err = system.GetSwapInfo()
+		case "du":
+			data, err = system.GetDUInfo(system.DUOptions{
+				Path:        config.Path,
+				Limit:       config.Limit,
+				Workers:     config.Workers,
+				CrossMounts: config.CrossMounts,
+			})
+		case "pressure":
+			prev, _ := last.(*models.PressureInfo)
+			data, err = system.GetPressureInfo(prev)
+		case "vmstat":
+			prev, _ := last.(*models.VMStatInfo)
+			data, err = system.GetVMStatInfo(prev)
+		case "stat":
+			prev, _ := last.(*models.KernelStatInfo)
+			data, err = system.GetKernelStatInfo(prev)
+		case "interrupts":
+			prev, _ := last.(*models.InterruptsInfo)
+			data, err = system.GetInterruptsInfo(prev, config.Limit)
+		case "numa":
+			data, err = system.GetNUMAInfo()
+		case "hugepages":
+			data, err = system.GetHugePagesInfo()
+		case "limits":
+			data, err = system.GetKernelLimits()
+		case "sysctl":
+			data, err = system.GetSysctlInfo(config.Prefixes, config.CompareFile)
+		case "modules":
+			data, err = system.GetModulesInfo(config.Names)
+		case "kernel":
+			data, err = system.GetKernelInfo()
+		case "time":
+			data, err = system.GetTimeInfo()
+		case "cgroups":
+			data, err = system.GetCgroupsInfo(config.SortBy, config.Depth)
+		case "containers":
+			data, err = system.GetContainersInfo(config.SortBy)
+		case "docker":
+			data, err = system.GetDockerInfo(config.Socket, config.Names)
+		case "virt":
+			data, err = system.GetVirtInfo()
+		case "hardware":
+			data, err = system.GetHardwareInfo()
+		case "devices":
+			data, err = system.GetDevicesInfo()
+		case "sensors":
+			data, err = system.GetSensorsInfo()
+		case "namespaces":
+			data, err = system.GetNamespacesInfo()
+		case "ipc":
+			data, err = system.GetIPCInfo()
 		default:
 			fmt.Fprintf(os.Stderr, "Unknown command: %s\n", config.Command)
 			os.Exit(1)
@@ -50,6 +124,10 @@ func main() {
 			os.Exit(1)
 		}
 
+		if du, ok := data.(*models.DUInfo); ok && du.Partial {
+			fmt.Fprintf(os.Stderr, "Warning: %d path(s) could not be read, results are partial\n", du.Errors)
+		}
+
 		formatter := output.NewFormatter(config.Format, config.Pretty)
 		formatted, err := formatter.Format(data, config.Command)
 		if err != nil {
@@ -63,10 +141,18 @@ func main() {
 			os.Exit(1)
 		}
 
+		// Drift is an exit condition so scripts and cron jobs can alert on it
+		if sysctl, ok := data.(*models.SysctlInfo); ok && len(sysctl.Mismatches) > 0 && !config.Watch {
+			fmt.Fprintf(os.Stderr, "%d sysctl value(s) differ from %s\n", len(sysctl.Mismatches), sysctl.ComparedWith)
+			os.Exit(1)
+		}
+
 		if !config.Watch {
 			break
 		}
 
+		last = data
+
 		<-ticker.C
 	}
 }
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"time"
+)
+
 // OSInfo represents operating system information
 type OSInfo struct {
 	Hostname       string `json:"hostname"`
@@ -12,21 +16,27 @@ type OSInfo struct {
 
 // CPUInfo represents CPU information
 type CPUInfo struct {
-	Cores        int     `json:"cores"`
-	Threads       int     `json:"threads"`
-	Model         string  `json:"model"`
-	FrequencyGHz  float64 `json:"frequency_ghz"`
-	UsagePercent  float64 `json:"usage_percent"`
+	Cores        int           `json:"cores"`
+	Threads      int           `json:"threads"`
+	Model        string        `json:"model"`
+	FrequencyGHz float64       `json:"frequency_ghz"`
+	UsagePercent float64       `json:"usage_percent"`
+	Scope        string        `json:"scope,omitempty"`
+	Host         *CPUInfo      `json:"host,omitempty"`
+	Cgroup       *CgroupLimits `json:"cgroup,omitempty"`
 }
 
 // MemoryInfo represents memory/RAM information
 type MemoryInfo struct {
-	TotalGB       float64 `json:"total_gb"`
-	AvailableGB   float64 `json:"available_gb"`
-	UsedGB        float64 `json:"used_gb"`
-	UsagePercent  float64 `json:"usage_percent"`
-	SwapTotalGB   float64 `json:"swap_total_gb"`
-	SwapUsedGB    float64 `json:"swap_used_gb"`
+	TotalGB      float64       `json:"total_gb"`
+	AvailableGB  float64       `json:"available_gb"`
+	UsedGB       float64       `json:"used_gb"`
+	UsagePercent float64       `json:"usage_percent"`
+	SwapTotalGB  float64       `json:"swap_total_gb"`
+	SwapUsedGB   float64       `json:"swap_used_gb"`
+	Scope        string        `json:"scope,omitempty"`
+	Host         *MemoryInfo   `json:"host,omitempty"`
+	Cgroup       *CgroupLimits `json:"cgroup,omitempty"`
 }
 
 // DiskInfo represents a single disk/partition
@@ -37,6 +47,39 @@ type DiskInfo struct {
 	UsedGB        float64 `json:"used_gb"`
 	AvailableGB   float64 `json:"available_gb"`
 	UsagePercent  float64 `json:"usage_percent"`
+	Timestamp     int64   `json:"timestamp,omitempty"`
+}
+
+// DiskForecast represents the projected fill rate of a mount point
+type DiskForecast struct {
+	Filesystem        string   `json:"filesystem"`
+	MountPoint        string   `json:"mount_point"`
+	UsagePercent      float64  `json:"usage_percent"`
+	ThresholdPercent  float64  `json:"threshold_percent"`
+	Samples           int      `json:"samples"`
+	FillRateGBPerHour float64  `json:"fill_rate_gb_per_hour"`
+	HoursToThreshold  *float64 `json:"hours_to_threshold"`
+	EstimatedFullAt   string   `json:"estimated_full_at,omitempty"`
+	Status            string   `json:"status"`
+}
+
+// LatencyStats summarizes latency samples for one operation in milliseconds
+type LatencyStats struct {
+	Operation string  `json:"operation"`
+	MinMs     float64 `json:"min_ms"`
+	AvgMs     float64 `json:"avg_ms"`
+	P50Ms     float64 `json:"p50_ms"`
+	P95Ms     float64 `json:"p95_ms"`
+	P99Ms     float64 `json:"p99_ms"`
+	MaxMs     float64 `json:"max_ms"`
+}
+
+// DiskProbeResult represents an active latency probe of a mount point
+type DiskProbeResult struct {
+	MountPoint string         `json:"mount_point"`
+	Iterations int            `json:"iterations"`
+	SizeBytes  int            `json:"size_bytes"`
+	Operations []LatencyStats `json:"operations"`
 }
 
 // NetworkInterface represents network interface information
@@ -56,6 +99,631 @@ type ProcessInfo struct {
 	MemoryMB    float64 `json:"memory_mb"`
 }
 
+// SwapDevice represents a single swap device or file from /proc/swaps
+type SwapDevice struct {
+	Filename string  `json:"filename"`
+	Type     string  `json:"type"`
+	SizeMB   float64 `json:"size_mb"`
+	UsedMB   float64 `json:"used_mb"`
+	Priority int     `json:"priority"`
+}
+
+// ZramDevice represents a compressed RAM block device
+type ZramDevice struct {
+	Name             string  `json:"name"`
+	Algorithm        string  `json:"algorithm"`
+	DiskSizeMB       float64 `json:"disk_size_mb"`
+	OriginalMB       float64 `json:"original_mb"`
+	CompressedMB     float64 `json:"compressed_mb"`
+	MemUsedMB        float64 `json:"mem_used_mb"`
+	CompressionRatio float64 `json:"compression_ratio"`
+}
+
+// ZswapInfo represents the zswap compressed swap cache settings
+type ZswapInfo struct {
+	Available  bool              `json:"available"`
+	Enabled    bool              `json:"enabled"`
+	Parameters map[string]string `json:"parameters"`
+}
+
+// SwapInfo represents swap devices along with zram and zswap state
+type SwapInfo struct {
+	Devices []SwapDevice `json:"devices"`
+	Zram    []ZramDevice `json:"zram"`
+	Zswap   ZswapInfo    `json:"zswap"`
+}
+
+// DUEntry represents a file or directory found by a usage scan
+type DUEntry struct {
+	Path        string  `json:"path"`
+	Type        string  `json:"type"`
+	ApparentMB  float64 `json:"apparent_mb"`
+	AllocatedMB float64 `json:"allocated_mb"`
+}
+
+// DUInfo represents the result of a directory usage scan
+type DUInfo struct {
+	Root             string    `json:"root"`
+	TotalApparentMB  float64   `json:"total_apparent_mb"`
+	TotalAllocatedMB float64   `json:"total_allocated_mb"`
+	Files            int64     `json:"files"`
+	Directories      int64     `json:"directories"`
+	TopDirectories   []DUEntry `json:"top_directories"`
+	TopFiles         []DUEntry `json:"top_files"`
+	Partial          bool      `json:"partial"`
+	Errors           int       `json:"errors"`
+	Warnings         []string  `json:"warnings"`
+}
+
+// PressureStat represents one "some" or "full" line of a PSI file
+type PressureStat struct {
+	Avg10       float64  `json:"avg10"`
+	Avg60       float64  `json:"avg60"`
+	Avg300      float64  `json:"avg300"`
+	TotalUs     uint64   `json:"total_us"`
+	RatePercent *float64 `json:"rate_percent,omitempty"`
+}
+
+// PressureResource represents PSI for one resource: cpu, memory or io
+type PressureResource struct {
+	Resource string       `json:"resource"`
+	Some     PressureStat `json:"some"`
+	Full     PressureStat `json:"full"`
+}
+
+// CgroupPressure represents PSI for a single cgroup
+type CgroupPressure struct {
+	Path      string             `json:"path"`
+	Resources []PressureResource `json:"resources"`
+}
+
+// PressureInfo represents Pressure Stall Information for the system
+type PressureInfo struct {
+	Available  bool               `json:"available"`
+	Message    string             `json:"message,omitempty"`
+	System     []PressureResource `json:"system"`
+	Cgroups    []CgroupPressure   `json:"cgroups"`
+	SampleTime time.Time          `json:"-"`
+}
+
+// CounterRate represents a cumulative kernel counter and its sampled rate
+type CounterRate struct {
+	Name      string   `json:"name"`
+	Total     uint64   `json:"total"`
+	PerSecond *float64 `json:"per_second,omitempty"`
+}
+
+// VMStatInfo represents virtual memory activity counters from /proc/vmstat
+type VMStatInfo struct {
+	Counters   []CounterRate `json:"counters"`
+	SampleTime time.Time     `json:"-"`
+}
+
+// KernelStatInfo represents system-wide activity counters from /proc/stat
+type KernelStatInfo struct {
+	Counters     []CounterRate `json:"counters"`
+	ProcsRunning uint64        `json:"procs_running"`
+	ProcsBlocked uint64        `json:"procs_blocked"`
+	SampleTime   time.Time     `json:"-"`
+}
+
+// IRQStat represents per-CPU counts for one interrupt or softirq source
+type IRQStat struct {
+	IRQ         string   `json:"irq"`
+	Description string   `json:"description"`
+	Total       uint64   `json:"total"`
+	PerCPU      []uint64 `json:"per_cpu"`
+	PerSecond   *float64 `json:"per_second,omitempty"`
+	Affinity    string   `json:"affinity,omitempty"`
+	Pinned      bool     `json:"pinned"`
+}
+
+// InterruptsInfo represents the interrupt and softirq distribution per CPU
+type InterruptsInfo struct {
+	CPUs       int               `json:"cpus"`
+	Interrupts []IRQStat         `json:"interrupts"`
+	SoftIRQs   []IRQStat         `json:"softirqs"`
+	Totals     map[string]uint64 `json:"-"`
+	SampleTime time.Time         `json:"-"`
+}
+
+// NUMANode represents a single NUMA node and its memory counters
+type NUMANode struct {
+	Node          int     `json:"node"`
+	CPUs          string  `json:"cpus"`
+	MemTotalMB    float64 `json:"mem_total_mb"`
+	MemFreeMB     float64 `json:"mem_free_mb"`
+	FilePagesMB   float64 `json:"file_pages_mb"`
+	UsagePercent  float64 `json:"usage_percent"`
+	NumaHit       uint64  `json:"numa_hit"`
+	NumaMiss      uint64  `json:"numa_miss"`
+	NumaForeign   uint64  `json:"numa_foreign"`
+	InterleaveHit uint64  `json:"interleave_hit"`
+	LocalNode     uint64  `json:"local_node"`
+	OtherNode     uint64  `json:"other_node"`
+	Distances     []int   `json:"distances"`
+}
+
+// NUMAInfo represents the NUMA topology of the system
+type NUMAInfo struct {
+	SingleNode bool       `json:"single_node"`
+	Message    string     `json:"message,omitempty"`
+	Nodes      []NUMANode `json:"nodes"`
+}
+
+// HugePagePool represents a static hugepage pool of one page size
+type HugePagePool struct {
+	SizeKB     uint64 `json:"size_kb"`
+	Total      uint64 `json:"total"`
+	Free       uint64 `json:"free"`
+	Reserved   uint64 `json:"reserved"`
+	Surplus    uint64 `json:"surplus"`
+	Overcommit uint64 `json:"overcommit"`
+}
+
+// THPInfo represents transparent hugepage settings and usage
+type THPInfo struct {
+	Available        bool              `json:"available"`
+	Enabled          string            `json:"enabled"`
+	Defrag           string            `json:"defrag"`
+	ShmemEnabled     string            `json:"shmem_enabled"`
+	KhugepagedDefrag string            `json:"khugepaged_defrag"`
+	AnonHugePagesMB  float64           `json:"anon_huge_pages_mb"`
+	ShmemHugePagesMB float64           `json:"shmem_huge_pages_mb"`
+	FileHugePagesMB  float64           `json:"file_huge_pages_mb"`
+	Counters         map[string]uint64 `json:"counters"`
+}
+
+// HugePagesInfo represents static hugepage pools and THP status
+type HugePagesInfo struct {
+	Pools []HugePagePool `json:"pools"`
+	THP   THPInfo        `json:"thp"`
+}
+
+// KernelLimit represents usage of a kernel resource table against its limit
+type KernelLimit struct {
+	Name               string   `json:"name"`
+	Used               uint64   `json:"used"`
+	Limit              uint64   `json:"limit"`
+	UtilizationPercent *float64 `json:"utilization_percent"`
+	Scope              string   `json:"scope"`
+	Note               string   `json:"note,omitempty"`
+}
+
+// SysctlEntry is a kernel parameter in dotted sysctl notation
+// IgnoreMissing is set for "-key" lines in sysctl.conf files
+type SysctlEntry struct {
+	Key           string `json:"key"`
+	Value         string `json:"value"`
+	IgnoreMissing bool   `json:"ignore_missing,omitempty"`
+}
+
+// SysctlError records a key that could not be read
+type SysctlError struct {
+	Key   string `json:"key"`
+	Error string `json:"error"`
+}
+
+// SysctlMismatch is a key whose live value differs from the desired one
+type SysctlMismatch struct {
+	Key      string `json:"key"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+	Missing  bool   `json:"missing,omitempty"`
+}
+
+// SysctlInfo represents a /proc/sys snapshot or a comparison against desired values
+type SysctlInfo struct {
+	Prefixes     []string         `json:"prefixes,omitempty"`
+	Entries      []SysctlEntry    `json:"entries,omitempty"`
+	Unreadable   []SysctlError    `json:"unreadable"`
+	ComparedWith string           `json:"compared_with,omitempty"`
+	Checked      int              `json:"checked,omitempty"`
+	Mismatches   []SysctlMismatch `json:"mismatches"`
+}
+
+// KernelModule represents a loaded kernel module from /proc/modules
+type KernelModule struct {
+	Name       string   `json:"name"`
+	SizeBytes  uint64   `json:"size_bytes"`
+	RefCount   int      `json:"refcount"`
+	UsedBy     []string `json:"used_by"`
+	State      string   `json:"state"`
+	Taint      string   `json:"taint,omitempty"`
+	TaintFlags []string `json:"taint_flags,omitempty"`
+	Version    string   `json:"version,omitempty"`
+	SrcVersion string   `json:"srcversion,omitempty"`
+}
+
+// ModulesInfo represents the loaded kernel module inventory
+type ModulesInfo struct {
+	Available bool           `json:"available"`
+	Message   string         `json:"message,omitempty"`
+	Tainted   int            `json:"tainted"`
+	Modules   []KernelModule `json:"modules"`
+}
+
+// BootParameter is one kernel command line token; flags have no value
+type BootParameter struct {
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+}
+
+// TaintFlag is a decoded bit of /proc/sys/kernel/tainted
+type TaintFlag struct {
+	Bit         int    `json:"bit"`
+	Letter      string `json:"letter"`
+	Description string `json:"description"`
+}
+
+// KernelInfo represents boot parameters, boot time and taint state
+type KernelInfo struct {
+	Release      string          `json:"release"`
+	Version      string          `json:"version"`
+	CommandLine  string          `json:"cmdline"`
+	Parameters   []BootParameter `json:"parameters"`
+	InitArgs     []string        `json:"init_args"`
+	BootTime     string          `json:"boot_time"`
+	BootTimeUnix int64           `json:"boot_time_unix"`
+	Tainted      uint64          `json:"tainted"`
+	TaintFlags   []TaintFlag     `json:"taint_flags"`
+}
+
+// NTPStatus represents the kernel clock discipline state from adjtimex
+type NTPStatus struct {
+	Available    bool     `json:"available"`
+	Message      string   `json:"message,omitempty"`
+	Synchronized bool     `json:"synchronized"`
+	State        string   `json:"state,omitempty"`
+	StatusFlags  []string `json:"status_flags,omitempty"`
+	OffsetMs     float64  `json:"offset_ms"`
+	FrequencyPPM float64  `json:"frequency_ppm"`
+	MaxErrorMs   float64  `json:"max_error_ms"`
+	EstErrorMs   float64  `json:"est_error_ms"`
+	TimeConstant int64    `json:"time_constant"`
+	TAIOffset    int32    `json:"tai_offset"`
+}
+
+// RTCStatus represents the hardware clock compared to system time
+type RTCStatus struct {
+	Available   bool   `json:"available"`
+	Name        string `json:"name,omitempty"`
+	Time        string `json:"time,omitempty"`
+	SkewSeconds int64  `json:"skew_seconds"`
+}
+
+// TimeInfo represents timezone, clock and time synchronization status
+type TimeInfo struct {
+	Timezone              string    `json:"timezone"`
+	TimezoneSource        string    `json:"timezone_source"`
+	TZ                    string    `json:"tz,omitempty"`
+	ZoneAbbrev            string    `json:"zone_abbrev"`
+	UTCOffset             string    `json:"utc_offset"`
+	UTC                   string    `json:"utc"`
+	Local                 string    `json:"local"`
+	NTP                   NTPStatus `json:"ntp"`
+	Clocksource           string    `json:"clocksource,omitempty"`
+	AvailableClocksources []string  `json:"available_clocksources"`
+	RTC                   RTCStatus `json:"rtc"`
+}
+
+// CgroupLimits represents the effective cgroup v2 limits and usage of this process
+// Nil limits mean unlimited
+type CgroupLimits struct {
+	Available        bool     `json:"available"`
+	Path             string   `json:"path,omitempty"`
+	MemoryMaxGB      *float64 `json:"memory_max_gb"`
+	MemoryCurrentGB  float64  `json:"memory_current_gb"`
+	OOMEvents        uint64   `json:"oom_events"`
+	OOMKillEvents    uint64   `json:"oom_kill_events"`
+	CPUQuotaUs       uint64   `json:"cpu_quota_us,omitempty"`
+	CPUPeriodUs      uint64   `json:"cpu_period_us,omitempty"`
+	QuotaCPUs        *float64 `json:"quota_cpus"`
+	CPUSet           string   `json:"cpuset,omitempty"`
+	EffectiveCPUs    *float64 `json:"effective_cpus"`
+	NrPeriods        uint64   `json:"nr_periods"`
+	NrThrottled      uint64   `json:"nr_throttled"`
+	ThrottledUsec    uint64   `json:"throttled_usec"`
+	ThrottledPercent float64  `json:"throttled_percent"`
+	PidsMax          *uint64  `json:"pids_max"`
+	PidsCurrent      uint64   `json:"pids_current"`
+}
+
+// CgroupNode represents one cgroup in the v2 hierarchy
+// Nil limits mean unlimited or not configured
+type CgroupNode struct {
+	Path            string        `json:"path"`
+	Name            string        `json:"name"`
+	MemoryCurrentMB float64       `json:"memory_current_mb"`
+	MemoryMaxMB     *float64      `json:"memory_max_mb"`
+	CPUUsageSec     float64       `json:"cpu_usage_sec"`
+	ThrottledSec    float64       `json:"throttled_sec"`
+	CPUQuotaCPUs    *float64      `json:"cpu_quota_cpus"`
+	IOReadMB        float64       `json:"io_read_mb"`
+	IOWriteMB       float64       `json:"io_write_mb"`
+	PidsCurrent     uint64        `json:"pids_current"`
+	PidsMax         *uint64       `json:"pids_max"`
+	Children        []*CgroupNode `json:"children"`
+}
+
+// CgroupsInfo represents the cgroup v2 hierarchy rooted at its mount point
+type CgroupsInfo struct {
+	Available bool        `json:"available"`
+	Message   string      `json:"message,omitempty"`
+	Mount     string      `json:"mount"`
+	Root      *CgroupNode `json:"root,omitempty"`
+}
+
+// ContainerInfo represents a container found through cgroup paths
+// Nil limits mean unlimited
+type ContainerInfo struct {
+	ID              string   `json:"id"`
+	Runtime         string   `json:"runtime"`
+	PodUID          string   `json:"pod_uid,omitempty"`
+	CgroupPath      string   `json:"cgroup_path"`
+	PIDs            []int    `json:"pids"`
+	Commands        []string `json:"commands"`
+	MemoryCurrentMB float64  `json:"memory_current_mb"`
+	MemoryMaxMB     *float64 `json:"memory_max_mb"`
+	CPUUsageSec     float64  `json:"cpu_usage_sec"`
+	ThrottledSec    float64  `json:"throttled_sec"`
+	CPUQuotaCPUs    *float64 `json:"cpu_quota_cpus"`
+	PidsCurrent     uint64   `json:"pids_current"`
+	PidsMax         *uint64  `json:"pids_max"`
+}
+
+// ContainersInfo represents all containers running on the host
+type ContainersInfo struct {
+	Message    string          `json:"message,omitempty"`
+	Containers []ContainerInfo `json:"containers"`
+}
+
+// DockerContainer represents a container reported by the Docker Engine API
+type DockerContainer struct {
+	ID            string            `json:"id"`
+	Name          string            `json:"name"`
+	Image         string            `json:"image"`
+	State         string            `json:"state"`
+	Status        string            `json:"status"`
+	Ports         []string          `json:"ports"`
+	Labels        map[string]string `json:"labels"`
+	CPUPercent    *float64          `json:"cpu_percent"`
+	MemoryUsageMB float64           `json:"memory_usage_mb"`
+	MemoryLimitMB float64           `json:"memory_limit_mb"`
+	MemoryPercent float64           `json:"memory_percent"`
+	NetRxMB       float64           `json:"net_rx_mb"`
+	NetTxMB       float64           `json:"net_tx_mb"`
+	BlockReadMB   float64           `json:"block_read_mb"`
+	BlockWriteMB  float64           `json:"block_write_mb"`
+	StatsError    string            `json:"stats_error,omitempty"`
+}
+
+// DockerInfo represents containers listed through the Engine API socket
+type DockerInfo struct {
+	Available  bool              `json:"available"`
+	Message    string            `json:"message,omitempty"`
+	Socket     string            `json:"socket"`
+	Containers []DockerContainer `json:"containers"`
+}
+
+// VirtInfo describes where sysinfo is running: bare metal, a VM or a container
+type VirtInfo struct {
+	Environment    string   `json:"environment"`
+	VirtualMachine bool     `json:"virtual_machine"`
+	Hypervisor     string   `json:"hypervisor,omitempty"`
+	Container      string   `json:"container,omitempty"`
+	Orchestrator   string   `json:"orchestrator,omitempty"`
+	WSL            string   `json:"wsl,omitempty"`
+	Chroot         bool     `json:"chroot"`
+	Evidence       []string `json:"evidence"`
+}
+
+// HardwareInfo is the DMI/SMBIOS inventory; Restricted lists root-only fields
+// that could not be read
+type HardwareInfo struct {
+	Available       bool     `json:"available"`
+	Message         string   `json:"message,omitempty"`
+	SystemVendor    string   `json:"system_vendor"`
+	ProductName     string   `json:"product_name"`
+	ProductVersion  string   `json:"product_version"`
+	ProductSerial   string   `json:"product_serial"`
+	ProductUUID     string   `json:"product_uuid"`
+	BoardVendor     string   `json:"board_vendor"`
+	BoardName       string   `json:"board_name"`
+	BIOSVendor      string   `json:"bios_vendor"`
+	BIOSVersion     string   `json:"bios_version"`
+	BIOSDate        string   `json:"bios_date"`
+	ChassisType     string   `json:"chassis_type"`
+	ChassisTypeCode int      `json:"chassis_type_code"`
+	ChassisAssetTag string   `json:"chassis_asset_tag"`
+	Restricted      []string `json:"restricted"`
+}
+
+// PCIDevice is one PCI function from /sys/bus/pci/devices; IDs are lowercase hex
+type PCIDevice struct {
+	Address           string `json:"address"`
+	Parent            string `json:"parent,omitempty"`
+	Class             string `json:"class"`
+	ClassName         string `json:"class_name,omitempty"`
+	VendorID          string `json:"vendor_id"`
+	DeviceID          string `json:"device_id"`
+	VendorName        string `json:"vendor_name,omitempty"`
+	DeviceName        string `json:"device_name,omitempty"`
+	SubsystemVendorID string `json:"subsystem_vendor_id"`
+	SubsystemDeviceID string `json:"subsystem_device_id"`
+	SubsystemName     string `json:"subsystem_name,omitempty"`
+	Driver            string `json:"driver,omitempty"`
+	NUMANode          *int   `json:"numa_node,omitempty"`
+	LinkSpeed         string `json:"link_speed,omitempty"`
+	LinkWidth         string `json:"link_width,omitempty"`
+	MaxLinkSpeed      string `json:"max_link_speed,omitempty"`
+	MaxLinkWidth      string `json:"max_link_width,omitempty"`
+}
+
+// USBDevice is one USB device from /sys/bus/usb/devices (interfaces excluded)
+type USBDevice struct {
+	Address      string `json:"address"`
+	Parent       string `json:"parent,omitempty"`
+	Bus          uint64 `json:"bus"`
+	Device       uint64 `json:"device"`
+	VendorID     string `json:"vendor_id"`
+	ProductID    string `json:"product_id"`
+	VendorName   string `json:"vendor_name,omitempty"`
+	ProductName  string `json:"product_name,omitempty"`
+	Manufacturer string `json:"manufacturer,omitempty"`
+	Product      string `json:"product,omitempty"`
+	Serial       string `json:"serial,omitempty"`
+	Class        string `json:"class"`
+	ClassName    string `json:"class_name,omitempty"`
+	Driver       string `json:"driver,omitempty"`
+	Speed        string `json:"speed_mbps,omitempty"`
+}
+
+// DevicesInfo lists PCI and USB devices and the ID databases used to name them
+type DevicesInfo struct {
+	PCIIDs string      `json:"pci_ids,omitempty"`
+	USBIDs string      `json:"usb_ids,omitempty"`
+	PCI    []PCIDevice `json:"pci"`
+	USB    []USBDevice `json:"usb"`
+}
+
+// TripPoint is a thermal zone temperature at which the kernel acts
+type TripPoint struct {
+	Type  string  `json:"type"`
+	TempC float64 `json:"temp_c"`
+}
+
+// ThermalZone is one /sys/class/thermal/thermal_zone* entry
+type ThermalZone struct {
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	TempC *float64    `json:"temp_c,omitempty"`
+	Trips []TripPoint `json:"trip_points"`
+}
+
+// SensorReading is one hwmon channel normalized to °C, RPM, V or W
+type SensorReading struct {
+	Sensor string   `json:"sensor"`
+	Type   string   `json:"type"`
+	Label  string   `json:"label,omitempty"`
+	Value  float64  `json:"value"`
+	Unit   string   `json:"unit"`
+	Min    *float64 `json:"min,omitempty"`
+	Max    *float64 `json:"max,omitempty"`
+	Crit   *float64 `json:"crit,omitempty"`
+}
+
+// HwmonChip is one /sys/class/hwmon/hwmon* device and its sensors
+type HwmonChip struct {
+	Hwmon   string          `json:"hwmon"`
+	Name    string          `json:"name"`
+	Device  string          `json:"device,omitempty"`
+	Sensors []SensorReading `json:"sensors"`
+}
+
+// SensorsInfo holds thermal zones, hwmon sensors and CPU thermal throttle counts
+type SensorsInfo struct {
+	ThermalZones         []ThermalZone `json:"thermal_zones"`
+	Hwmon                []HwmonChip   `json:"hwmon"`
+	CoreThrottleCount    uint64        `json:"core_throttle_count"`
+	PackageThrottleCount uint64        `json:"package_throttle_count"`
+}
+
+// Namespace is one namespace of a given type and the processes in it
+type Namespace struct {
+	Type        string `json:"type"`
+	Inode       uint64 `json:"inode"`
+	Root        bool   `json:"root"`
+	Processes   int    `json:"processes"`
+	ExamplePID  int    `json:"example_pid"`
+	Command     string `json:"command"`
+	OwnerUserNS uint64 `json:"owner_user_ns,omitempty"`
+}
+
+// NamespacesInfo groups processes by namespace; Counts is namespaces per type
+type NamespacesInfo struct {
+	Processes  int            `json:"processes"`
+	Unreadable int            `json:"unreadable"`
+	Counts     map[string]int `json:"counts"`
+	Namespaces []Namespace    `json:"namespaces"`
+}
+
+// SysVShm is one System V shared memory segment from /proc/sysvipc/shm
+type SysVShm struct {
+	Key        string `json:"key"`
+	ID         int    `json:"id"`
+	Owner      string `json:"owner"`
+	UID        uint32 `json:"uid"`
+	Perms      string `json:"perms"`
+	SizeBytes  uint64 `json:"size_bytes"`
+	RSSBytes   uint64 `json:"rss_bytes"`
+	SwapBytes  uint64 `json:"swap_bytes"`
+	CreatorPID int    `json:"creator_pid"`
+	LastPID    int    `json:"last_pid"`
+	Attached   int    `json:"attached"`
+	Removed    bool   `json:"removed"`
+	Locked     bool   `json:"locked"`
+	Orphaned   bool   `json:"orphaned"`
+}
+
+// SysVSemaphore is one System V semaphore set from /proc/sysvipc/sem
+type SysVSemaphore struct {
+	Key   string `json:"key"`
+	ID    int    `json:"id"`
+	Owner string `json:"owner"`
+	UID   uint32 `json:"uid"`
+	Perms string `json:"perms"`
+	NSems int    `json:"nsems"`
+}
+
+// SysVMessageQueue is one System V message queue from /proc/sysvipc/msg
+type SysVMessageQueue struct {
+	Key         string `json:"key"`
+	ID          int    `json:"id"`
+	Owner       string `json:"owner"`
+	UID         uint32 `json:"uid"`
+	Perms       string `json:"perms"`
+	Bytes       uint64 `json:"bytes"`
+	Messages    uint64 `json:"messages"`
+	LastSendPID int    `json:"last_send_pid"`
+	LastRecvPID int    `json:"last_recv_pid"`
+}
+
+// ShmFile is a POSIX shared memory file; Processes counts mappers and openers
+type ShmFile struct {
+	Path           string `json:"path"`
+	SizeBytes      uint64 `json:"size_bytes"`
+	AllocatedBytes uint64 `json:"allocated_bytes"`
+	Owner          string `json:"owner"`
+	UID            uint32 `json:"uid"`
+	Modified       string `json:"modified"`
+	Processes      int    `json:"processes"`
+}
+
+// DevShmInfo is /dev/shm filesystem usage and the files in it
+type DevShmInfo struct {
+	Path                string    `json:"path"`
+	TotalBytes          uint64    `json:"total_bytes"`
+	UsedBytes           uint64    `json:"used_bytes"`
+	AvailableBytes      uint64    `json:"available_bytes"`
+	UsagePercent        float64   `json:"usage_percent"`
+	FilesBytes          uint64    `json:"files_bytes"`
+	Unused              int       `json:"unused"`
+	UnusedBytes         uint64    `json:"unused_bytes"`
+	UnreadableProcesses int       `json:"unreadable_processes"`
+	Files               []ShmFile `json:"files"`
+}
+
+// IPCInfo holds System V IPC objects and POSIX shared memory usage
+type IPCInfo struct {
+	SharedMemory  []SysVShm          `json:"shared_memory"`
+	ShmTotalBytes uint64             `json:"shm_total_bytes"`
+	ShmOrphaned   int                `json:"shm_orphaned"`
+	Semaphores    []SysVSemaphore    `json:"semaphores"`
+	MessageQueues []SysVMessageQueue `json:"message_queues"`
+	DevShm        DevShmInfo         `json:"dev_shm"`
+}
+
 // OutputFormats defines supported output types
 type OutputFormat string
 
@@ -3,6 +3,9 @@ package output
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/example/sysinfo-cli/internal/models"
 )
@@ -65,11 +68,62 @@ func (f *Formatter) formatTable(data interface{}, command string) (string, error
 	case "memory":
 		result = formatMemoryTable(data.(*models.MemoryInfo))
 	case "disk":
-		result = formatDiskTable(data.([]models.DiskInfo))
+		switch d := data.(type) {
+		case []models.DiskForecast:
+			result = formatDiskForecastTable(d)
+		case *models.DiskProbeResult:
+			result = formatDiskProbeTable(d)
+		default:
+			result = formatDiskTable(data.([]models.DiskInfo))
+		}
 	case "network":
 		result = formatNetworkTable(data.([]models.NetworkInterface))
 	case "process":
 		result = formatProcessTable(data.([]models.ProcessInfo))
+	case "swap":
+		result = formatSwapTable(data.(*models.SwapInfo))
+	case "du":
+		result = formatDUTable(data.(*models.DUInfo))
+	case "pressure":
+		result = formatPressureTable(data.(*models.PressureInfo))
+	case "vmstat":
+		result = formatCounterTable("Virtual Memory Activity:", data.(*models.VMStatInfo).Counters)
+	case "stat":
+		result = formatKernelStatTable(data.(*models.KernelStatInfo))
+	case "interrupts":
+		result = formatInterruptsTable(data.(*models.InterruptsInfo))
+	case "numa":
+		result = formatNUMATable(data.(*models.NUMAInfo))
+	case "hugepages":
+		result = formatHugePagesTable(data.(*models.HugePagesInfo))
+	case "limits":
+		result = formatKernelLimitsTable(data.([]models.KernelLimit))
+	case "sysctl":
+		result = formatSysctlTable(data.(*models.SysctlInfo))
+	case "modules":
+		result = formatModulesTable(data.(*models.ModulesInfo))
+	case "kernel":
+		result = formatKernelTable(data.(*models.KernelInfo))
+	case "time":
+		result = formatTimeTable(data.(*models.TimeInfo))
+	case "cgroups":
+		result = formatCgroupsTable(data.(*models.CgroupsInfo))
+	case "containers":
+		result = formatContainersTable(data.(*models.ContainersInfo))
+	case "docker":
+		result = formatDockerTable(data.(*models.DockerInfo))
+	case "virt":
+		result = formatVirtTable(data.(*models.VirtInfo))
+	case "hardware":
+		result = formatHardwareTable(data.(*models.HardwareInfo))
+	case "devices":
+		result = formatDevicesTable(data.(*models.DevicesInfo))
+	case "sensors":
+		result = formatSensorsTable(data.(*models.SensorsInfo))
+	case "namespaces":
+		result = formatNamespacesTable(data.(*models.NamespacesInfo))
+	case "ipc":
+		result = formatIPCTable(data.(*models.IPCInfo))
 	default:
 		return "", fmt.Errorf("unknown command: %s", command)
 	}
@@ -88,11 +142,62 @@ func (f *Formatter) formatCSV(data interface{}, command string) (string, error)
 	case "memory":
 		result = formatMemoryCSV(data.(*models.MemoryInfo))
 	case "disk":
-		result = formatDiskCSV(data.([]models.DiskInfo))
+		switch d := data.(type) {
+		case []models.DiskForecast:
+			result = formatDiskForecastCSV(d)
+		case *models.DiskProbeResult:
+			result = formatDiskProbeCSV(d)
+		default:
+			result = formatDiskCSV(data.([]models.DiskInfo))
+		}
 	case "network":
 		result = formatNetworkCSV(data.([]models.NetworkInterface))
 	case "process":
 		result = formatProcessCSV(data.([]models.ProcessInfo))
+	case "swap":
+		result = formatSwapCSV(data.(*models.SwapInfo))
+	case "du":
+		result = formatDUCSV(data.(*models.DUInfo))
+	case "pressure":
+		result = formatPressureCSV(data.(*models.PressureInfo))
+	case "vmstat":
+		result = formatCounterCSV(data.(*models.VMStatInfo).Counters)
+	case "stat":
+		result = formatKernelStatCSV(data.(*models.KernelStatInfo))
+	case "interrupts":
+		result = formatInterruptsCSV(data.(*models.InterruptsInfo))
+	case "numa":
+		result = formatNUMACSV(data.(*models.NUMAInfo))
+	case "hugepages":
+		result = formatHugePagesCSV(data.(*models.HugePagesInfo))
+	case "limits":
+		result = formatKernelLimitsCSV(data.([]models.KernelLimit))
+	case "sysctl":
+		result = formatSysctlCSV(data.(*models.SysctlInfo))
+	case "modules":
+		result = formatModulesCSV(data.(*models.ModulesInfo))
+	case "kernel":
+		result = formatKernelCSV(data.(*models.KernelInfo))
+	case "time":
+		result = formatTimeCSV(data.(*models.TimeInfo))
+	case "cgroups":
+		result = formatCgroupsCSV(data.(*models.CgroupsInfo))
+	case "containers":
+		result = formatContainersCSV(data.(*models.ContainersInfo))
+	case "docker":
+		result = formatDockerCSV(data.(*models.DockerInfo))
+	case "virt":
+		result = formatVirtCSV(data.(*models.VirtInfo))
+	case "hardware":
+		result = formatHardwareCSV(data.(*models.HardwareInfo))
+	case "devices":
+		result = formatDevicesCSV(data.(*models.DevicesInfo))
+	case "sensors":
+		result = formatSensorsCSV(data.(*models.SensorsInfo))
+	case "namespaces":
+		result = formatNamespacesCSV(data.(*models.NamespacesInfo))
+	case "ipc":
+		result = formatIPCCSV(data.(*models.IPCInfo))
 	default:
 		return "", fmt.Errorf("unknown command: %s", command)
 	}
@@ -114,7 +219,7 @@ func formatOSTable(info *models.OSInfo) string {
 }
 
 func formatCPUTable(info *models.CPUInfo) string {
-	return fmt.Sprintf(`CPU Information:
+	result := fmt.Sprintf(`CPU Information:
   Cores:         %d
   Threads:       %d
   Model:         %s
@@ -122,10 +227,39 @@ func formatCPUTable(info *models.CPUInfo) string {
   Usage:         %.2f%%
 `,
 		info.Cores, info.Threads, info.Model, info.FrequencyGHz, info.UsagePercent)
+
+	if info.Scope != "" {
+		result += fmt.Sprintf("  Scope:         %s\n", info.Scope)
+	}
+	if info.Host != nil {
+		result += fmt.Sprintf("  Host Cores:    %d\n", info.Host.Cores)
+	}
+
+	if c := info.Cgroup; c != nil {
+		quota := "unlimited"
+		if c.QuotaCPUs != nil {
+			quota = fmt.Sprintf("%d/%d us (%.2f CPUs)", c.CPUQuotaUs, c.CPUPeriodUs, *c.QuotaCPUs)
+		}
+		effective := "unlimited"
+		if c.EffectiveCPUs != nil {
+			effective = fmt.Sprintf("%.2f", *c.EffectiveCPUs)
+		}
+		result += fmt.Sprintf(`
+Cgroup CPU (%s):
+  Quota:         %s
+  Cpuset:        %s
+  Effective:     %s
+  Throttled:     %d/%d periods (%.2f%%), %.2f s total
+`,
+			c.Path, quota, valueOrDash(c.CPUSet), effective,
+			c.NrThrottled, c.NrPeriods, c.ThrottledPercent, float64(c.ThrottledUsec)/1e6)
+	}
+
+	return result
 }
 
 func formatMemoryTable(info *models.MemoryInfo) string {
-	return fmt.Sprintf(`Memory Information:
+	result := fmt.Sprintf(`Memory Information:
   Total:         %.2f GB
   Available:     %.2f GB
   Used:          %.2f GB
@@ -134,6 +268,51 @@ func formatMemoryTable(info *models.MemoryInfo) string {
   Swap Used:     %.2f GB
 `,
 		info.TotalGB, info.AvailableGB, info.UsedGB, info.UsagePercent, info.SwapTotalGB, info.SwapUsedGB)
+
+	if info.Scope != "" {
+		result += fmt.Sprintf("  Scope:         %s\n", info.Scope)
+	}
+	if info.Host != nil {
+		result += fmt.Sprintf("  Host Total:    %.2f GB\n  Host Used:     %.2f GB\n", info.Host.TotalGB, info.Host.UsedGB)
+	}
+
+	if c := info.Cgroup; c != nil {
+		limit := "unlimited"
+		if c.MemoryMaxGB != nil {
+			limit = fmt.Sprintf("%.2f GB", *c.MemoryMaxGB)
+		}
+		pidsMax := "unlimited"
+		if c.PidsMax != nil {
+			pidsMax = fmt.Sprintf("%d", *c.PidsMax)
+		}
+		result += fmt.Sprintf(`
+Cgroup Memory (%s):
+  Limit:         %s
+  Current:       %.2f GB
+  OOM Events:    %d
+  OOM Kills:     %d
+  PIDs:          %d / %s
+`,
+			c.Path, limit, c.MemoryCurrentGB, c.OOMEvents, c.OOMKillEvents, c.PidsCurrent, pidsMax)
+	}
+
+	return result
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+func containsString(items []string, value string) bool {
+	for _, item := range items {
+		if item == value {
+			return true
+		}
+	}
+	return false
 }
 
 func formatDiskTable(disks []models.DiskInfo) string {
@@ -149,6 +328,51 @@ func formatDiskTable(disks []models.DiskInfo) string {
 	return result
 }
 
+func formatDiskForecastTable(forecasts []models.DiskForecast) string {
+	result := "Disk Forecast:\n"
+	result += "  Mount Point       Usage%  Threshold  Rate(GB/h)  Time Left     Status\n"
+	result += "  ---------------  -------  ---------  ----------  ------------  -----------------\n"
+
+	for _, f := range forecasts {
+		timeLeft := "-"
+		if f.HoursToThreshold != nil {
+			timeLeft = formatHours(*f.HoursToThreshold)
+		}
+		result += fmt.Sprintf("  %-15s  %6.2f%%  %8.1f%%  %10.3f  %-12s  %s\n",
+			f.MountPoint, f.UsagePercent, f.ThresholdPercent, f.FillRateGBPerHour, timeLeft, f.Status)
+	}
+
+	return result
+}
+
+func formatDiskProbeTable(probe *models.DiskProbeResult) string {
+	result := fmt.Sprintf("Disk Latency Probe: %s (%d iterations, %d bytes)\n",
+		probe.MountPoint, probe.Iterations, probe.SizeBytes)
+	result += "  Operation   Min(ms)   Avg(ms)   P50(ms)   P95(ms)   P99(ms)   Max(ms)\n"
+	result += "  ---------  --------  --------  --------  --------  --------  --------\n"
+
+	for _, op := range probe.Operations {
+		result += fmt.Sprintf("  %-9s  %8.3f  %8.3f  %8.3f  %8.3f  %8.3f  %8.3f\n",
+			op.Operation, op.MinMs, op.AvgMs, op.P50Ms, op.P95Ms, op.P99Ms, op.MaxMs)
+	}
+
+	return result
+}
+
+// formatHours renders a duration in hours as "2d 3h" or "4h 30m"
+func formatHours(hours float64) string {
+	minutes := int64(hours * 60)
+	days := minutes / (24 * 60)
+	minutes -= days * 24 * 60
+	h := minutes / 60
+	minutes -= h * 60
+
+	if days > 0 {
+		return fmt.Sprintf("%dd %dh", days, h)
+	}
+	return fmt.Sprintf("%dh %dm", h, minutes)
+}
+
 func formatNetworkTable(ifaces []models.NetworkInterface) string {
 	result := "Network Interfaces:\n"
 	result += "  Name            Status  IP Addresses\n"
@@ -180,6 +404,841 @@ func formatProcessTable(processes []models.ProcessInfo) string {
 	return result
 }
 
+func formatSwapTable(info *models.SwapInfo) string {
+	result := "Swap Devices:\n"
+	result += "  Filename              Type        Size(MB)    Used(MB)  Priority\n"
+	result += "  --------------------  ---------  ----------  ----------  --------\n"
+
+	for _, d := range info.Devices {
+		result += fmt.Sprintf("  %-20s  %-9s  %10.2f  %10.2f  %8d\n",
+			d.Filename, d.Type, d.SizeMB, d.UsedMB, d.Priority)
+	}
+
+	result += "\nZram Devices:\n"
+	result += "  Name      Algorithm   Disk(MB)  Original(MB)  Compressed(MB)  Ratio\n"
+	result += "  --------  ---------  ---------  ------------  --------------  ------\n"
+
+	for _, z := range info.Zram {
+		result += fmt.Sprintf("  %-8s  %-9s  %9.2f  %12.2f  %14.2f  %5.2fx\n",
+			z.Name, z.Algorithm, z.DiskSizeMB, z.OriginalMB, z.CompressedMB, z.CompressionRatio)
+	}
+
+	result += "\nZswap:\n"
+	if !info.Zswap.Available {
+		result += fmt.Sprintf("  %-26s %s\n", "Status:", "not available")
+		return result
+	}
+
+	status := "disabled"
+	if info.Zswap.Enabled {
+		status = "enabled"
+	}
+	result += fmt.Sprintf("  %-26s %s\n", "Status:", status)
+
+	for _, k := range sortedKeys(info.Zswap.Parameters) {
+		result += fmt.Sprintf("  %-26s %s\n", k+":", info.Zswap.Parameters[k])
+	}
+
+	return result
+}
+
+func formatDUTable(info *models.DUInfo) string {
+	result := fmt.Sprintf(`Directory Usage:
+  Root:          %s
+  Apparent:      %.2f MB
+  Allocated:     %.2f MB
+  Directories:   %d
+  Files:         %d
+`,
+		info.Root, info.TotalApparentMB, info.TotalAllocatedMB, info.Directories, info.Files)
+
+	if info.Partial {
+		result += fmt.Sprintf("  Warning:       %d path(s) could not be read, results are partial\n", info.Errors)
+	}
+
+	result += "\nLargest Directories:\n"
+	result += formatDUEntries(info.TopDirectories)
+	result += "\nLargest Files:\n"
+	result += formatDUEntries(info.TopFiles)
+
+	return result
+}
+
+func formatDUEntries(entries []models.DUEntry) string {
+	result := "  Allocated(MB)  Apparent(MB)  Path\n"
+	result += "  -------------  ------------  ------------------------------------------\n"
+
+	for _, e := range entries {
+		result += fmt.Sprintf("  %13.2f  %12.2f  %s\n", e.AllocatedMB, e.ApparentMB, e.Path)
+	}
+
+	return result
+}
+
+func formatPressureTable(info *models.PressureInfo) string {
+	if !info.Available {
+		return fmt.Sprintf("Pressure Stall Information:\n  Status:        %s\n", info.Message)
+	}
+
+	result := "Pressure Stall Information:\n"
+	result += formatPressureRows(info.System)
+
+	if len(info.Cgroups) > 0 {
+		result += "\nCgroup Pressure:\n"
+		for _, cg := range info.Cgroups {
+			result += fmt.Sprintf("  %s\n", cg.Path)
+			result += formatPressureRows(cg.Resources)
+		}
+	}
+
+	return result
+}
+
+func formatPressureRows(resources []models.PressureResource) string {
+	result := "  Resource  Type    avg10    avg60   avg300      Total(s)    Rate%\n"
+	result += "  --------  ----  -------  -------  -------  ------------  -------\n"
+
+	for _, r := range resources {
+		for _, line := range []struct {
+			kind string
+			stat models.PressureStat
+		}{{"some", r.Some}, {"full", r.Full}} {
+			rate := "-"
+			if line.stat.RatePercent != nil {
+				rate = fmt.Sprintf("%.2f", *line.stat.RatePercent)
+			}
+			result += fmt.Sprintf("  %-8s  %-4s  %7.2f  %7.2f  %7.2f  %12.3f  %7s\n",
+				r.Resource, line.kind, line.stat.Avg10, line.stat.Avg60, line.stat.Avg300,
+				float64(line.stat.TotalUs)/1e6, rate)
+		}
+	}
+
+	return result
+}
+
+func formatCounterTable(title string, counters []models.CounterRate) string {
+	result := title + "\n"
+	result += "  Counter                              Total    Per Second\n"
+	result += "  ------------------------  ----------------  ------------\n"
+
+	for _, c := range counters {
+		rate := "-"
+		if c.PerSecond != nil {
+			rate = fmt.Sprintf("%.2f", *c.PerSecond)
+		}
+		result += fmt.Sprintf("  %-24s  %16d  %12s\n", c.Name, c.Total, rate)
+	}
+
+	return result
+}
+
+func formatKernelStatTable(info *models.KernelStatInfo) string {
+	result := formatCounterTable("Kernel Activity:", info.Counters)
+	result += fmt.Sprintf(`
+  Procs Running: %d
+  Procs Blocked: %d
+`,
+		info.ProcsRunning, info.ProcsBlocked)
+	return result
+}
+
+func formatInterruptsTable(info *models.InterruptsInfo) string {
+	result := fmt.Sprintf("Interrupts (%d CPUs):\n", info.CPUs)
+	result += formatIRQRows(info.Interrupts)
+	result += "\nSoftIRQs:\n"
+	result += formatIRQRows(info.SoftIRQs)
+	return result
+}
+
+func formatIRQRows(irqs []models.IRQStat) string {
+	result := "  IRQ               Total    Rate/s  Busiest CPU  Affinity    Pinned  Description\n"
+	result += "  --------  ------------  --------  -----------  ----------  ------  ------------------------------\n"
+
+	for _, irq := range irqs {
+		rate := "-"
+		if irq.PerSecond != nil {
+			rate = fmt.Sprintf("%.1f", *irq.PerSecond)
+		}
+		pinned := ""
+		if irq.Pinned {
+			pinned = "yes"
+		}
+		row := fmt.Sprintf("  %-8s  %12d  %8s  %-11s  %-10s  %-6s  %s",
+			irq.IRQ, irq.Total, rate, busiestCPU(irq), irq.Affinity, pinned, irq.Description)
+		result += strings.TrimRight(row, " ") + "\n"
+	}
+
+	return result
+}
+
+// busiestCPU renders the CPU with the highest count and its share, e.g. "3 (97%)"
+func busiestCPU(irq models.IRQStat) string {
+	if irq.Total == 0 || len(irq.PerCPU) < 2 {
+		return "-"
+	}
+
+	busiest := 0
+	for cpu, count := range irq.PerCPU {
+		if count > irq.PerCPU[busiest] {
+			busiest = cpu
+		}
+	}
+	return fmt.Sprintf("%d (%.0f%%)", busiest, float64(irq.PerCPU[busiest])/float64(irq.Total)*100)
+}
+
+func formatNUMATable(info *models.NUMAInfo) string {
+	result := "NUMA Nodes:\n"
+	if info.Message != "" {
+		result += fmt.Sprintf("  Status:        %s\n", info.Message)
+	}
+	result += "  Node  CPUs                Total(MB)   Free(MB)  File(MB)   Usage%     numa_hit   numa_miss  numa_foreign\n"
+	result += "  ----  ------------------  ---------  ---------  --------  -------  -----------  ----------  ------------\n"
+
+	for _, n := range info.Nodes {
+		result += fmt.Sprintf("  %-4d  %-18s  %9.0f  %9.0f  %8.0f  %6.2f%%  %11d  %10d  %12d\n",
+			n.Node, n.CPUs, n.MemTotalMB, n.MemFreeMB, n.FilePagesMB, n.UsagePercent,
+			n.NumaHit, n.NumaMiss, n.NumaForeign)
+	}
+
+	if len(info.Nodes) > 1 {
+		result += "\nNode Distances:\n      "
+		for _, n := range info.Nodes {
+			result += fmt.Sprintf("  %4d", n.Node)
+		}
+		result += "\n"
+		for _, n := range info.Nodes {
+			result += fmt.Sprintf("  %4d", n.Node)
+			for _, d := range n.Distances {
+				result += fmt.Sprintf("  %4d", d)
+			}
+			result += "\n"
+		}
+	}
+
+	return result
+}
+
+func formatHugePagesTable(info *models.HugePagesInfo) string {
+	result := "Hugepage Pools:\n"
+	result += "  Page Size        Total        Free    Reserved     Surplus\n"
+	result += "  ---------  ----------  ----------  ----------  ----------\n"
+
+	for _, p := range info.Pools {
+		result += fmt.Sprintf("  %-9s  %10d  %10d  %10d  %10d\n",
+			formatPageSize(p.SizeKB), p.Total, p.Free, p.Reserved, p.Surplus)
+	}
+
+	result += "\nTransparent Hugepages:\n"
+	if !info.THP.Available {
+		result += "  Status:        not available\n"
+		return result
+	}
+
+	result += fmt.Sprintf(`  Enabled:       %s
+  Defrag:        %s
+  Shmem:         %s
+  AnonHuge:      %.2f MB
+  ShmemHuge:     %.2f MB
+  FileHuge:      %.2f MB
+`,
+		info.THP.Enabled, info.THP.Defrag, info.THP.ShmemEnabled,
+		info.THP.AnonHugePagesMB, info.THP.ShmemHugePagesMB, info.THP.FileHugePagesMB)
+
+	for _, k := range sortedKeys(info.THP.Counters) {
+		result += fmt.Sprintf("  %-28s %d\n", k+":", info.THP.Counters[k])
+	}
+
+	return result
+}
+
+func formatKernelLimitsTable(limits []models.KernelLimit) string {
+	result := "Kernel Limits:\n"
+	result += "  Name                     Used       Limit    Usage%  Scope     Note\n"
+	result += "  -----------------  ----------  ----------  --------  --------  ----\n"
+
+	for _, l := range limits {
+		limit, usage := "-", "-"
+		if l.Limit > 0 {
+			limit = fmt.Sprintf("%d", l.Limit)
+		}
+		if l.UtilizationPercent != nil {
+			usage = fmt.Sprintf("%.2f%%", *l.UtilizationPercent)
+		}
+		row := fmt.Sprintf("  %-17s  %10d  %10s  %8s  %-8s  %s",
+			l.Name, l.Used, limit, usage, l.Scope, l.Note)
+		result += strings.TrimRight(row, " ") + "\n"
+	}
+
+	return result
+}
+
+func formatSysctlTable(info *models.SysctlInfo) string {
+	var result string
+
+	if info.ComparedWith != "" {
+		result = fmt.Sprintf(`Sysctl Comparison:
+  Desired:       %s
+  Checked:       %d
+  Mismatches:    %d
+`, info.ComparedWith, info.Checked, len(info.Mismatches))
+
+		if len(info.Mismatches) > 0 {
+			width := 3
+			for _, m := range info.Mismatches {
+				width = max(width, len(m.Key))
+			}
+			result += fmt.Sprintf("\n  %-*s  %-20s  %s\n", width, "Key", "Expected", "Actual")
+			result += fmt.Sprintf("  %s  %s  %s\n", strings.Repeat("-", width), strings.Repeat("-", 20), strings.Repeat("-", 20))
+			for _, m := range info.Mismatches {
+				actual := m.Actual
+				if m.Missing {
+					actual = "(missing)"
+				}
+				result += fmt.Sprintf("  %-*s  %-20s  %s\n", width, m.Key, m.Expected, actual)
+			}
+		}
+	} else {
+		width := 3
+		for _, e := range info.Entries {
+			width = max(width, len(e.Key))
+		}
+		result = "Sysctl Values:\n"
+		result += fmt.Sprintf("  %-*s  %s\n", width, "Key", "Value")
+		result += fmt.Sprintf("  %s  %s\n", strings.Repeat("-", width), strings.Repeat("-", 20))
+		for _, e := range info.Entries {
+			result += strings.TrimRight(fmt.Sprintf("  %-*s  %s", width, e.Key, e.Value), " ") + "\n"
+		}
+	}
+
+	if len(info.Unreadable) > 0 {
+		result += "\nUnreadable Keys:\n"
+		for _, u := range info.Unreadable {
+			result += fmt.Sprintf("  %s: %s\n", u.Key, u.Error)
+		}
+	}
+
+	return result
+}
+
+func formatModulesTable(info *models.ModulesInfo) string {
+	result := "Kernel Modules:\n"
+	if !info.Available {
+		result += fmt.Sprintf("  Status:        %s\n", info.Message)
+		return result
+	}
+	result += fmt.Sprintf("  Loaded:        %d\n  Tainted:       %d\n\n", len(info.Modules), info.Tainted)
+
+	result += "  Name                  Size(KB)  Refs  State      Taint  Version       Used By\n"
+	result += "  --------------------  --------  ----  ---------  -----  ------------  --------------------\n"
+
+	for _, m := range info.Modules {
+		taint, version, usedBy := "-", "-", "-"
+		if m.Taint != "" {
+			taint = m.Taint
+		}
+		if m.Version != "" {
+			version = m.Version
+		}
+		if len(m.UsedBy) > 0 {
+			usedBy = strings.Join(m.UsedBy, ",")
+		}
+		result += fmt.Sprintf("  %-20s  %8d  %4d  %-9s  %-5s  %-12s  %s\n",
+			m.Name, m.SizeBytes/1024, m.RefCount, m.State, taint, version, usedBy)
+	}
+
+	return result
+}
+
+func formatKernelTable(info *models.KernelInfo) string {
+	taint := "not tainted"
+	if info.Tainted != 0 {
+		letters := make([]string, len(info.TaintFlags))
+		for i, f := range info.TaintFlags {
+			letters[i] = f.Letter
+		}
+		taint = fmt.Sprintf("%d (%s)", info.Tainted, strings.Join(letters, ""))
+	}
+
+	result := fmt.Sprintf(`Kernel:
+  Release:       %s
+  Version:       %s
+  Boot Time:     %s
+  Tainted:       %s
+  Command Line:  %s
+`, info.Release, info.Version, info.BootTime, taint, info.CommandLine)
+
+	if len(info.TaintFlags) > 0 {
+		result += "\nTaint Flags:\n"
+		result += "  Bit  Flag  Description\n"
+		result += "  ---  ----  --------------------------------------------------\n"
+		for _, f := range info.TaintFlags {
+			result += fmt.Sprintf("  %3d  %-4s  %s\n", f.Bit, f.Letter, f.Description)
+		}
+	}
+
+	width := 9
+	for _, p := range info.Parameters {
+		width = max(width, len(p.Key))
+	}
+	result += "\nBoot Parameters:\n"
+	result += fmt.Sprintf("  %-*s  %s\n", width, "Parameter", "Value")
+	result += fmt.Sprintf("  %s  %s\n", strings.Repeat("-", width), strings.Repeat("-", 20))
+	for _, p := range info.Parameters {
+		result += strings.TrimRight(fmt.Sprintf("  %-*s  %s", width, p.Key, p.Value), " ") + "\n"
+	}
+
+	if len(info.InitArgs) > 0 {
+		result += fmt.Sprintf("\nInit Arguments:  %s\n", strings.Join(info.InitArgs, " "))
+	}
+
+	return result
+}
+
+func formatTimeTable(info *models.TimeInfo) string {
+	result := fmt.Sprintf(`Time:
+  Timezone:      %s (%s)
+  Zone:          %s (UTC%s)
+  UTC:           %s
+  Local:         %s
+  Clocksource:   %s
+  Available:     %s
+`,
+		info.Timezone, info.TimezoneSource, info.ZoneAbbrev, info.UTCOffset,
+		info.UTC, info.Local, info.Clocksource, strings.Join(info.AvailableClocksources, " "))
+
+	result += "\nNTP Synchronization:\n"
+	if !info.NTP.Available {
+		result += fmt.Sprintf("  Status:        %s\n", info.NTP.Message)
+	} else {
+		result += fmt.Sprintf(`  Synchronized:  %t
+  State:         %s
+  Status Flags:  %s
+  Offset:        %.3f ms
+  Frequency:     %.3f ppm
+  Max Error:     %.3f ms
+  Est Error:     %.3f ms
+  Time Constant: %d
+  TAI Offset:    %d s
+`,
+			info.NTP.Synchronized, info.NTP.State, strings.Join(info.NTP.StatusFlags, " "),
+			info.NTP.OffsetMs, info.NTP.FrequencyPPM, info.NTP.MaxErrorMs, info.NTP.EstErrorMs,
+			info.NTP.TimeConstant, info.NTP.TAIOffset)
+	}
+
+	result += "\nHardware Clock:\n"
+	if !info.RTC.Available {
+		result += "  Status:        not available\n"
+	} else {
+		result += fmt.Sprintf("  Device:        %s\n  RTC Time:      %s\n  Skew:          %+d s\n",
+			info.RTC.Name, info.RTC.Time, info.RTC.SkewSeconds)
+	}
+
+	return result
+}
+
+func formatCgroupsTable(info *models.CgroupsInfo) string {
+	result := fmt.Sprintf("Cgroup Hierarchy (%s):\n", info.Mount)
+	if !info.Available {
+		result += fmt.Sprintf("  Status:        %s\n", info.Message)
+		return result
+	}
+
+	result += "  Memory(MB)  Limit(MB)     CPU(s)  Throttled(s)  Quota  IO Read(MB)  IO Write(MB)   Pids  Pids Max  Cgroup\n"
+	result += "  ----------  ---------  ---------  ------------  -----  -----------  ------------  -----  --------  ------------------------\n"
+
+	var walk func(node *models.CgroupNode, prefix, connector, childPrefix string)
+	walk = func(node *models.CgroupNode, prefix, connector, childPrefix string) {
+		memLimit, quota, pidsMax := "-", "-", "-"
+		if node.MemoryMaxMB != nil {
+			memLimit = fmt.Sprintf("%.0f", *node.MemoryMaxMB)
+		}
+		if node.CPUQuotaCPUs != nil {
+			quota = fmt.Sprintf("%.2f", *node.CPUQuotaCPUs)
+		}
+		if node.PidsMax != nil {
+			pidsMax = fmt.Sprintf("%d", *node.PidsMax)
+		}
+
+		name := node.Name
+		if node.Path == "/" {
+			name = "/"
+		}
+		result += fmt.Sprintf("  %10.1f  %9s  %9.1f  %12.1f  %5s  %11.1f  %12.1f  %5d  %8s  %s%s%s\n",
+			node.MemoryCurrentMB, memLimit, node.CPUUsageSec, node.ThrottledSec, quota,
+			node.IOReadMB, node.IOWriteMB, node.PidsCurrent, pidsMax, prefix, connector, name)
+
+		for i, child := range node.Children {
+			if i == len(node.Children)-1 {
+				walk(child, prefix+childPrefix, "└── ", "    ")
+			} else {
+				walk(child, prefix+childPrefix, "├── ", "│   ")
+			}
+		}
+	}
+	walk(info.Root, "", "", "")
+
+	return result
+}
+
+func formatContainersTable(info *models.ContainersInfo) string {
+	result := "Containers:\n"
+	if info.Message != "" {
+		result += fmt.Sprintf("  Warning:       %s\n", info.Message)
+	}
+	if len(info.Containers) == 0 {
+		result += "  No containers found\n"
+		return result
+	}
+
+	result += "  ID            Runtime     Pod UID                                 CPU(s)  Quota  Memory(MB)  Limit(MB)  Pids  Commands\n"
+	result += "  ------------  ----------  ------------------------------------  --------  -----  ----------  ---------  ----  --------------------\n"
+
+	for _, c := range info.Containers {
+		pod, quota, memLimit := "-", "-", "-"
+		if c.PodUID != "" {
+			pod = c.PodUID
+		}
+		if c.CPUQuotaCPUs != nil {
+			quota = fmt.Sprintf("%.2f", *c.CPUQuotaCPUs)
+		}
+		if c.MemoryMaxMB != nil {
+			memLimit = fmt.Sprintf("%.0f", *c.MemoryMaxMB)
+		}
+		result += fmt.Sprintf("  %-12s  %-10s  %-36s  %8.1f  %5s  %10.1f  %9s  %4d  %s\n",
+			c.ID[:12], c.Runtime, pod, c.CPUUsageSec, quota, c.MemoryCurrentMB, memLimit,
+			len(c.PIDs), strings.Join(c.Commands, ","))
+	}
+
+	return result
+}
+
+func formatDockerTable(info *models.DockerInfo) string {
+	result := fmt.Sprintf("Docker Containers (%s):\n", info.Socket)
+	if !info.Available {
+		result += fmt.Sprintf("  Status:        %s\n", info.Message)
+		return result
+	}
+	if len(info.Containers) == 0 {
+		result += "  No running containers\n"
+		return result
+	}
+
+	result += "  ID            Name                  Image                     CPU%   Mem(MB)  Limit(MB)   Mem%  Net RX/TX(MB)  Block R/W(MB)  Ports\n"
+	result += "  ------------  --------------------  --------------------  -------  --------  ---------  -----  -------------  -------------  --------------------\n"
+
+	for _, c := range info.Containers {
+		cpu := "-"
+		if c.CPUPercent != nil {
+			cpu = fmt.Sprintf("%.2f%%", *c.CPUPercent)
+		}
+		row := fmt.Sprintf("  %-12.12s  %-20s  %-20s  %7s  %8.1f  %9.1f  %4.1f%%  %13s  %13s  %s",
+			c.ID, c.Name, c.Image, cpu, c.MemoryUsageMB, c.MemoryLimitMB, c.MemoryPercent,
+			fmt.Sprintf("%.1f/%.1f", c.NetRxMB, c.NetTxMB), fmt.Sprintf("%.1f/%.1f", c.BlockReadMB, c.BlockWriteMB),
+			strings.Join(c.Ports, ", "))
+		result += strings.TrimRight(row, " ") + "\n"
+		if c.StatsError != "" {
+			result += fmt.Sprintf("  %12s  stats unavailable: %s\n", "", c.StatsError)
+		}
+	}
+
+	return result
+}
+
+func formatVirtTable(info *models.VirtInfo) string {
+	wsl := "no"
+	if info.WSL != "" {
+		wsl = "WSL" + info.WSL
+	}
+	chroot := "no"
+	if info.Chroot {
+		chroot = "yes"
+	}
+
+	result := fmt.Sprintf(`Virtualization:
+  Environment:   %s
+  Hypervisor:    %s
+  Container:     %s
+  Orchestrator:  %s
+  WSL:           %s
+  Chroot:        %s
+`, info.Environment, valueOrDash(info.Hypervisor), valueOrDash(info.Container),
+		valueOrDash(info.Orchestrator), wsl, chroot)
+
+	if len(info.Evidence) > 0 {
+		result += "\nEvidence:\n"
+		for _, e := range info.Evidence {
+			result += "  - " + e + "\n"
+		}
+	}
+
+	return result
+}
+
+func formatHardwareTable(info *models.HardwareInfo) string {
+	if !info.Available {
+		return fmt.Sprintf("Hardware:\n  %s\n", info.Message)
+	}
+
+	field := func(name, value string) string {
+		if containsString(info.Restricted, name) {
+			return "(restricted, run as root)"
+		}
+		return valueOrDash(value)
+	}
+
+	chassis := valueOrDash(info.ChassisType)
+	if info.ChassisTypeCode != 0 {
+		chassis = fmt.Sprintf("%s (%d)", info.ChassisType, info.ChassisTypeCode)
+	}
+
+	return fmt.Sprintf(`System:
+  Vendor:        %s
+  Product:       %s
+  Version:       %s
+  Serial:        %s
+  UUID:          %s
+
+Board:
+  Vendor:        %s
+  Name:          %s
+
+BIOS:
+  Vendor:        %s
+  Version:       %s
+  Date:          %s
+
+Chassis:
+  Type:          %s
+  Asset Tag:     %s
+`, field("system_vendor", info.SystemVendor), field("product_name", info.ProductName),
+		field("product_version", info.ProductVersion), field("product_serial", info.ProductSerial),
+		field("product_uuid", info.ProductUUID), field("board_vendor", info.BoardVendor),
+		field("board_name", info.BoardName), field("bios_vendor", info.BIOSVendor),
+		field("bios_version", info.BIOSVersion), field("bios_date", info.BIOSDate),
+		field("chassis_type", chassis), field("chassis_asset_tag", info.ChassisAssetTag))
+}
+
+func formatDevicesTable(info *models.DevicesInfo) string {
+	result := "PCI Devices:\n"
+	result += "  Address       Class   ID         Driver           NUMA  Link           Name\n"
+	result += "  ------------  ------  ---------  ---------------  ----  -------------  --------------------\n"
+	for _, d := range info.PCI {
+		numa := "-"
+		if d.NUMANode != nil {
+			numa = fmt.Sprintf("%d", *d.NUMANode)
+		}
+		link := "-"
+		if d.LinkSpeed != "" {
+			link = strings.TrimSuffix(d.LinkSpeed, " PCIe") + " x" + d.LinkWidth
+		}
+		name := strings.TrimSpace(d.VendorName + " " + d.DeviceName)
+		if d.SubsystemName != "" {
+			name += " (" + d.SubsystemName + ")"
+		}
+		result += strings.TrimRight(fmt.Sprintf("  %-12s  %-6s  %s:%s  %-15s  %-4s  %-13s  %s",
+			d.Address, d.Class, d.VendorID, d.DeviceID, valueOrDash(d.Driver), numa, link,
+			valueOrDash(name)), " ") + "\n"
+	}
+
+	result += "\nUSB Devices:\n"
+	result += "  Address       Bus  Dev  ID         Class  Driver           Speed  Name\n"
+	result += "  ------------  ---  ---  ---------  -----  ---------------  -----  --------------------\n"
+	for _, d := range info.USB {
+		// Fall back to the strings the device reports when usb.ids has no entry
+		vendor, product := d.VendorName, d.ProductName
+		if vendor == "" {
+			vendor = d.Manufacturer
+		}
+		if product == "" {
+			product = d.Product
+		}
+		result += strings.TrimRight(fmt.Sprintf("  %-12s  %03d  %03d  %s:%s  %-5s  %-15s  %-5s  %s",
+			d.Address, d.Bus, d.Device, d.VendorID, d.ProductID, d.Class, valueOrDash(d.Driver),
+			valueOrDash(d.Speed), valueOrDash(strings.TrimSpace(vendor+" "+product))), " ") + "\n"
+	}
+
+	if info.PCIIDs == "" || info.USBIDs == "" {
+		result += "\nNames are resolved only when pci.ids/usb.ids is installed (hwdata or pciutils/usbutils).\n"
+	}
+
+	return result
+}
+
+func formatSensorsTable(info *models.SensorsInfo) string {
+	if len(info.ThermalZones) == 0 && len(info.Hwmon) == 0 {
+		return "Sensors:\n  No thermal zones or hwmon sensors found\n"
+	}
+
+	result := "Thermal Zones:\n"
+	result += "  Zone             Type              Temp      Trip Points\n"
+	result += "  ---------------  ----------------  --------  ------------------------------\n"
+	for _, z := range info.ThermalZones {
+		trips := make([]string, len(z.Trips))
+		for i, trip := range z.Trips {
+			trips[i] = fmt.Sprintf("%s %.1f°C", trip.Type, trip.TempC)
+		}
+		result += strings.TrimRight(fmt.Sprintf("  %-15s  %-16s  %s  %s",
+			z.Name, z.Type, padRunes(formatSensorValue(z.TempC, "°C"), 8), strings.Join(trips, ", ")), " ") + "\n"
+	}
+
+	for _, chip := range info.Hwmon {
+		name := chip.Hwmon
+		if chip.Device != "" {
+			name += ", " + chip.Device
+		}
+		result += fmt.Sprintf("\n%s (%s):\n", valueOrDash(chip.Name), name)
+		result += "  Sensor    Label             Value         Min         Max         Crit\n"
+		result += "  --------  ----------------  ------------  ----------  ----------  ----------\n"
+		for _, s := range chip.Sensors {
+			value := s.Value
+			result += strings.TrimRight(fmt.Sprintf("  %-8s  %-16s  %s  %s  %s  %s",
+				s.Sensor, valueOrDash(s.Label), padRunes(formatSensorValue(&value, s.Unit), 12),
+				padRunes(formatSensorValue(s.Min, s.Unit), 10), padRunes(formatSensorValue(s.Max, s.Unit), 10),
+				formatSensorValue(s.Crit, s.Unit)), " ") + "\n"
+		}
+	}
+
+	result += fmt.Sprintf("\nThermal Throttling:\n  Core Events:     %d\n  Package Events:  %d\n",
+		info.CoreThrottleCount, info.PackageThrottleCount)
+
+	return result
+}
+
+// formatSensorValue renders a reading with its unit, or "-" when absent
+func formatSensorValue(value *float64, unit string) string {
+	switch {
+	case value == nil:
+		return "-"
+	case unit == "RPM":
+		return fmt.Sprintf("%.0f %s", *value, unit)
+	case unit == "V":
+		return fmt.Sprintf("%.3f %s", *value, unit)
+	default:
+		return fmt.Sprintf("%.1f %s", *value, unit)
+	}
+}
+
+func formatNamespacesTable(info *models.NamespacesInfo) string {
+	result := fmt.Sprintf("Namespaces:\n  Processes:     %d", info.Processes)
+	if info.Unreadable > 0 {
+		result += fmt.Sprintf(" (%d unreadable, run as root for all)", info.Unreadable)
+	}
+	result += "\n"
+	// Namespaces arrive sorted by type, so this lists types in display order
+	for i, ns := range info.Namespaces {
+		if i == 0 || info.Namespaces[i-1].Type != ns.Type {
+			result += fmt.Sprintf("  %-14s %d\n", ns.Type+":", info.Counts[ns.Type])
+		}
+	}
+
+	result += "\n  Type    Inode       Root  Procs  Example PID  Command          Owner User NS\n"
+	result += "  ------  ----------  ----  -----  -----------  ---------------  -------------\n"
+	for _, ns := range info.Namespaces {
+		root := ""
+		if ns.Root {
+			root = "yes"
+		}
+		owner := "-"
+		if ns.OwnerUserNS != 0 {
+			owner = fmt.Sprintf("%d", ns.OwnerUserNS)
+		}
+		result += fmt.Sprintf("  %-6s  %-10d  %-4s  %5d  %11d  %-15s  %s\n",
+			ns.Type, ns.Inode, root, ns.Processes, ns.ExamplePID, valueOrDash(ns.Command), owner)
+	}
+
+	return result
+}
+
+func formatIPCTable(info *models.IPCInfo) string {
+	const mb = 1024 * 1024
+	shm := info.DevShm
+
+	result := fmt.Sprintf(`POSIX Shared Memory (%s):
+  Size:          %.2f MB
+  Used:          %.2f MB (%.1f%%)
+  Available:     %.2f MB
+  Files:         %d (%.2f MB)
+  Unused:        %d (%.2f MB, not mapped or open by any process)
+`, shm.Path, float64(shm.TotalBytes)/mb, float64(shm.UsedBytes)/mb, shm.UsagePercent,
+		float64(shm.AvailableBytes)/mb, len(shm.Files), float64(shm.FilesBytes)/mb,
+		shm.Unused, float64(shm.UnusedBytes)/mb)
+	if shm.UnreadableProcesses > 0 {
+		result += fmt.Sprintf("  Note:          %d process(es) unreadable, run as root for exact usage\n", shm.UnreadableProcesses)
+	}
+
+	if len(shm.Files) > 0 {
+		result += "\n  Allocated(MB)  Procs  Owner       Modified                   Path\n"
+		result += "  -------------  -----  ----------  -------------------------  --------------------\n"
+		for _, f := range shm.Files {
+			result += fmt.Sprintf("  %13.2f  %5d  %-10s  %-25s  %s\n",
+				float64(f.AllocatedBytes)/mb, f.Processes, f.Owner, f.Modified, f.Path)
+		}
+	}
+
+	result += fmt.Sprintf("\nSystem V Shared Memory: %d segment(s), %.2f MB, %d orphaned\n",
+		len(info.SharedMemory), float64(info.ShmTotalBytes)/mb, info.ShmOrphaned)
+	if len(info.SharedMemory) > 0 {
+		result += "  Key         ID        Owner       Perms  Size(MB)    RSS(MB)  Attached  CPID    LPID    Status\n"
+		result += "  ----------  --------  ----------  -----  ---------  ---------  --------  ------  ------  --------\n"
+		for _, s := range info.SharedMemory {
+			status := make([]string, 0)
+			if s.Orphaned {
+				status = append(status, "orphaned")
+			}
+			if s.Removed {
+				status = append(status, "removed")
+			}
+			if s.Locked {
+				status = append(status, "locked")
+			}
+			result += strings.TrimRight(fmt.Sprintf("  %-10s  %-8d  %-10s  %-5s  %9.2f  %9.2f  %8d  %-6d  %-6d  %s",
+				s.Key, s.ID, s.Owner, s.Perms, float64(s.SizeBytes)/mb, float64(s.RSSBytes)/mb,
+				s.Attached, s.CreatorPID, s.LastPID, strings.Join(status, ",")), " ") + "\n"
+		}
+	}
+
+	result += fmt.Sprintf("\nSystem V Semaphores: %d set(s)\n", len(info.Semaphores))
+	if len(info.Semaphores) > 0 {
+		result += "  Key         ID        Owner       Perms  NSems\n"
+		result += "  ----------  --------  ----------  -----  -----\n"
+		for _, s := range info.Semaphores {
+			result += fmt.Sprintf("  %-10s  %-8d  %-10s  %-5s  %5d\n", s.Key, s.ID, s.Owner, s.Perms, s.NSems)
+		}
+	}
+
+	result += fmt.Sprintf("\nSystem V Message Queues: %d queue(s)\n", len(info.MessageQueues))
+	if len(info.MessageQueues) > 0 {
+		result += "  Key         ID        Owner       Perms  Bytes       Messages  Last Send  Last Recv\n"
+		result += "  ----------  --------  ----------  -----  ----------  --------  ---------  ---------\n"
+		for _, q := range info.MessageQueues {
+			result += fmt.Sprintf("  %-10s  %-8d  %-10s  %-5s  %-10d  %8d  %-9d  %d\n",
+				q.Key, q.ID, q.Owner, q.Perms, q.Bytes, q.Messages, q.LastSendPID, q.LastRecvPID)
+		}
+	}
+
+	return result
+}
+
+// padRunes pads to width characters; %-Ns counts bytes, which misaligns "°C"
+func padRunes(s string, width int) string {
+	if n := utf8.RuneCountInString(s); n < width {
+		return s + strings.Repeat(" ", width-n)
+	}
+	return s
+}
+
+// formatPageSize renders a page size in kB as "2MB" or "1GB"
+func formatPageSize(kb uint64) string {
+	switch {
+	case kb >= 1024*1024 && kb%(1024*1024) == 0:
+		return fmt.Sprintf("%dGB", kb/(1024*1024))
+	case kb >= 1024 && kb%1024 == 0:
+		return fmt.Sprintf("%dMB", kb/1024)
+	default:
+		return fmt.Sprintf("%dkB", kb)
+	}
+}
+
 // CSV formatters
 func formatOSCSV(info *models.OSInfo) string {
 	return fmt.Sprintf("hostname,os,platform,release,architecture,uptime_seconds\n%s,%s,%s,%s,%s,%d\n",
@@ -187,13 +1246,44 @@ func formatOSCSV(info *models.OSInfo) string {
 }
 
 func formatCPUCSV(info *models.CPUInfo) string {
-	return fmt.Sprintf("cores,threads,model,frequency_ghz,usage_percent\n%d,%d,%s,%.2f,%.2f\n",
+	result := fmt.Sprintf("cores,threads,model,frequency_ghz,usage_percent\n%d,%d,%s,%.2f,%.2f\n",
 		info.Cores, info.Threads, info.Model, info.FrequencyGHz, info.UsagePercent)
+	// Host scope keeps the single-section shape existing consumers parse
+	if info.Cgroup != nil && info.Scope == "container" {
+		result += "\n" + formatCgroupLimitsCSV(info.Scope, info.Cgroup)
+	}
+	return result
 }
 
 func formatMemoryCSV(info *models.MemoryInfo) string {
-	return fmt.Sprintf("total_gb,available_gb,used_gb,usage_percent,swap_total_gb,swap_used_gb\n%.2f,%.2f,%.2f,%.2f,%.2f,%.2f\n",
+	result := fmt.Sprintf("total_gb,available_gb,used_gb,usage_percent,swap_total_gb,swap_used_gb\n%.2f,%.2f,%.2f,%.2f,%.2f,%.2f\n",
 		info.TotalGB, info.AvailableGB, info.UsedGB, info.UsagePercent, info.SwapTotalGB, info.SwapUsedGB)
+	// Host scope keeps the single-section shape existing consumers parse
+	if info.Cgroup != nil && info.Scope == "container" {
+		result += "\n" + formatCgroupLimitsCSV(info.Scope, info.Cgroup)
+	}
+	return result
+}
+
+// formatCgroupLimitsCSV renders cgroup limits as a second CSV section; unlimited values are empty
+func formatCgroupLimitsCSV(scope string, c *models.CgroupLimits) string {
+	optional := func(v *float64) string {
+		if v == nil {
+			return ""
+		}
+		return fmt.Sprintf("%.2f", *v)
+	}
+	pidsMax := ""
+	if c.PidsMax != nil {
+		pidsMax = fmt.Sprintf("%d", *c.PidsMax)
+	}
+
+	result := "scope,cgroup,memory_max_gb,memory_current_gb,oom_events,oom_kill_events,quota_cpus,effective_cpus,nr_periods,nr_throttled,throttled_usec,pids_current,pids_max\n"
+	result += fmt.Sprintf("%s,%s,%s,%.2f,%d,%d,%s,%s,%d,%d,%d,%d,%s\n",
+		scope, c.Path, optional(c.MemoryMaxGB), c.MemoryCurrentGB, c.OOMEvents, c.OOMKillEvents,
+		optional(c.QuotaCPUs), optional(c.EffectiveCPUs), c.NrPeriods, c.NrThrottled, c.ThrottledUsec,
+		c.PidsCurrent, pidsMax)
+	return result
 }
 
 func formatDiskCSV(disks []models.DiskInfo) string {
@@ -205,6 +1295,29 @@ func formatDiskCSV(disks []models.DiskInfo) string {
 	return result
 }
 
+func formatDiskForecastCSV(forecasts []models.DiskForecast) string {
+	result := "filesystem,mount_point,usage_percent,threshold_percent,samples,fill_rate_gb_per_hour,hours_to_threshold,estimated_full_at,status\n"
+	for _, f := range forecasts {
+		hours := ""
+		if f.HoursToThreshold != nil {
+			hours = fmt.Sprintf("%.2f", *f.HoursToThreshold)
+		}
+		result += fmt.Sprintf("%s,%s,%.2f,%.2f,%d,%.4f,%s,%s,%s\n",
+			f.Filesystem, f.MountPoint, f.UsagePercent, f.ThresholdPercent, f.Samples,
+			f.FillRateGBPerHour, hours, f.EstimatedFullAt, f.Status)
+	}
+	return result
+}
+
+func formatDiskProbeCSV(probe *models.DiskProbeResult) string {
+	result := "mount_point,operation,iterations,min_ms,avg_ms,p50_ms,p95_ms,p99_ms,max_ms\n"
+	for _, op := range probe.Operations {
+		result += fmt.Sprintf("%s,%s,%d,%.3f,%.3f,%.3f,%.3f,%.3f,%.3f\n",
+			probe.MountPoint, op.Operation, probe.Iterations, op.MinMs, op.AvgMs, op.P50Ms, op.P95Ms, op.P99Ms, op.MaxMs)
+	}
+	return result
+}
+
 func formatNetworkCSV(ifaces []models.NetworkInterface) string {
 	result := "name,ip_addresses,mac_address,mtu,status\n"
 	for _, i := range ifaces {
@@ -227,3 +1340,409 @@ func formatProcessCSV(processes []models.ProcessInfo) string {
 	}
 	return result
 }
+
+func formatSwapCSV(info *models.SwapInfo) string {
+	result := "filename,type,size_mb,used_mb,priority\n"
+	for _, d := range info.Devices {
+		result += fmt.Sprintf("%s,%s,%.2f,%.2f,%d\n", csvField(d.Filename), d.Type, d.SizeMB, d.UsedMB, d.Priority)
+	}
+
+	result += "\nname,algorithm,disk_size_mb,original_mb,compressed_mb,mem_used_mb,compression_ratio\n"
+	for _, z := range info.Zram {
+		result += fmt.Sprintf("%s,%s,%.2f,%.2f,%.2f,%.2f,%.2f\n",
+			z.Name, z.Algorithm, z.DiskSizeMB, z.OriginalMB, z.CompressedMB, z.MemUsedMB, z.CompressionRatio)
+	}
+
+	result += "\nzswap_parameter,value\n"
+	result += fmt.Sprintf("available,%t\n", info.Zswap.Available)
+	result += fmt.Sprintf("enabled,%t\n", info.Zswap.Enabled)
+	for _, k := range sortedKeys(info.Zswap.Parameters) {
+		result += fmt.Sprintf("%s,%s\n", k, info.Zswap.Parameters[k])
+	}
+	return result
+}
+
+func formatDUCSV(info *models.DUInfo) string {
+	result := "type,path,apparent_mb,allocated_mb\n"
+	for _, e := range info.TopDirectories {
+		result += fmt.Sprintf("%s,%s,%.2f,%.2f\n", e.Type, csvField(e.Path), e.ApparentMB, e.AllocatedMB)
+	}
+	for _, e := range info.TopFiles {
+		result += fmt.Sprintf("%s,%s,%.2f,%.2f\n", e.Type, csvField(e.Path), e.ApparentMB, e.AllocatedMB)
+	}
+	return result
+}
+
+func formatPressureCSV(info *models.PressureInfo) string {
+	result := "scope,resource,type,avg10,avg60,avg300,total_us,rate_percent\n"
+
+	write := func(scope string, resources []models.PressureResource) {
+		for _, r := range resources {
+			for _, line := range []struct {
+				kind string
+				stat models.PressureStat
+			}{{"some", r.Some}, {"full", r.Full}} {
+				rate := ""
+				if line.stat.RatePercent != nil {
+					rate = fmt.Sprintf("%.2f", *line.stat.RatePercent)
+				}
+				result += fmt.Sprintf("%s,%s,%s,%.2f,%.2f,%.2f,%d,%s\n",
+					scope, r.Resource, line.kind, line.stat.Avg10, line.stat.Avg60, line.stat.Avg300,
+					line.stat.TotalUs, rate)
+			}
+		}
+	}
+
+	write("system", info.System)
+	for _, cg := range info.Cgroups {
+		write(csvField(cg.Path), cg.Resources)
+	}
+	return result
+}
+
+func formatCounterCSV(counters []models.CounterRate) string {
+	result := "name,total,per_second\n"
+	for _, c := range counters {
+		rate := ""
+		if c.PerSecond != nil {
+			rate = fmt.Sprintf("%.2f", *c.PerSecond)
+		}
+		result += fmt.Sprintf("%s,%d,%s\n", c.Name, c.Total, rate)
+	}
+	return result
+}
+
+func formatKernelStatCSV(info *models.KernelStatInfo) string {
+	result := formatCounterCSV(info.Counters)
+	result += fmt.Sprintf("procs_running,%d,\nprocs_blocked,%d,\n", info.ProcsRunning, info.ProcsBlocked)
+	return result
+}
+
+func formatInterruptsCSV(info *models.InterruptsInfo) string {
+	result := "kind,irq,total,per_second,affinity,pinned,per_cpu,description\n"
+
+	write := func(kind string, irqs []models.IRQStat) {
+		for _, irq := range irqs {
+			rate := ""
+			if irq.PerSecond != nil {
+				rate = fmt.Sprintf("%.2f", *irq.PerSecond)
+			}
+			counts := make([]string, len(irq.PerCPU))
+			for i, c := range irq.PerCPU {
+				counts[i] = fmt.Sprintf("%d", c)
+			}
+			result += fmt.Sprintf("%s,%s,%d,%s,%s,%t,%s,%s\n",
+				kind, irq.IRQ, irq.Total, rate, strings.ReplaceAll(irq.Affinity, ",", ";"), irq.Pinned,
+				strings.Join(counts, ";"), strings.ReplaceAll(irq.Description, ",", ";"))
+		}
+	}
+
+	write("irq", info.Interrupts)
+	write("softirq", info.SoftIRQs)
+	return result
+}
+
+func formatNUMACSV(info *models.NUMAInfo) string {
+	result := "node,cpus,mem_total_mb,mem_free_mb,file_pages_mb,usage_percent,numa_hit,numa_miss,numa_foreign,interleave_hit,local_node,other_node,distances\n"
+	for _, n := range info.Nodes {
+		distances := make([]string, len(n.Distances))
+		for i, d := range n.Distances {
+			distances[i] = fmt.Sprintf("%d", d)
+		}
+		result += fmt.Sprintf("%d,%s,%.2f,%.2f,%.2f,%.2f,%d,%d,%d,%d,%d,%d,%s\n",
+			n.Node, strings.ReplaceAll(n.CPUs, ",", ";"), n.MemTotalMB, n.MemFreeMB, n.FilePagesMB, n.UsagePercent,
+			n.NumaHit, n.NumaMiss, n.NumaForeign, n.InterleaveHit, n.LocalNode, n.OtherNode,
+			strings.Join(distances, ";"))
+	}
+	return result
+}
+
+func formatHugePagesCSV(info *models.HugePagesInfo) string {
+	result := "size_kb,total,free,reserved,surplus,overcommit\n"
+	for _, p := range info.Pools {
+		result += fmt.Sprintf("%d,%d,%d,%d,%d,%d\n", p.SizeKB, p.Total, p.Free, p.Reserved, p.Surplus, p.Overcommit)
+	}
+
+	result += "\nthp_setting,value\n"
+	result += fmt.Sprintf("enabled,%s\ndefrag,%s\nshmem_enabled,%s\nkhugepaged_defrag,%s\n",
+		info.THP.Enabled, info.THP.Defrag, info.THP.ShmemEnabled, info.THP.KhugepagedDefrag)
+	result += fmt.Sprintf("anon_huge_pages_mb,%.2f\nshmem_huge_pages_mb,%.2f\nfile_huge_pages_mb,%.2f\n",
+		info.THP.AnonHugePagesMB, info.THP.ShmemHugePagesMB, info.THP.FileHugePagesMB)
+	for _, k := range sortedKeys(info.THP.Counters) {
+		result += fmt.Sprintf("%s,%d\n", k, info.THP.Counters[k])
+	}
+	return result
+}
+
+func formatKernelLimitsCSV(limits []models.KernelLimit) string {
+	result := "name,used,limit,utilization_percent,scope,note\n"
+	for _, l := range limits {
+		usage := ""
+		if l.UtilizationPercent != nil {
+			usage = fmt.Sprintf("%.2f", *l.UtilizationPercent)
+		}
+		result += fmt.Sprintf("%s,%d,%d,%s,%s,%s\n", l.Name, l.Used, l.Limit, usage, l.Scope, l.Note)
+	}
+	return result
+}
+
+func formatSysctlCSV(info *models.SysctlInfo) string {
+	if info.ComparedWith != "" {
+		result := "key,expected,actual,missing\n"
+		for _, m := range info.Mismatches {
+			result += fmt.Sprintf("%s,%s,%s,%t\n", m.Key, csvField(m.Expected), csvField(m.Actual), m.Missing)
+		}
+		return result
+	}
+
+	result := "key,value\n"
+	for _, e := range info.Entries {
+		result += fmt.Sprintf("%s,%s\n", e.Key, csvField(e.Value))
+	}
+	return result
+}
+
+func formatModulesCSV(info *models.ModulesInfo) string {
+	result := "name,size_bytes,refcount,used_by,state,taint,taint_flags,version,srcversion\n"
+	for _, m := range info.Modules {
+		result += fmt.Sprintf("%s,%d,%d,%s,%s,%s,%s,%s,%s\n",
+			m.Name, m.SizeBytes, m.RefCount, strings.Join(m.UsedBy, ";"), m.State,
+			m.Taint, strings.Join(m.TaintFlags, ";"), m.Version, m.SrcVersion)
+	}
+	return result
+}
+
+func formatKernelCSV(info *models.KernelInfo) string {
+	result := "type,key,value\n"
+	result += fmt.Sprintf("kernel,release,%s\n", csvField(info.Release))
+	result += fmt.Sprintf("kernel,version,%s\n", csvField(info.Version))
+	result += fmt.Sprintf("kernel,boot_time,%s\n", info.BootTime)
+	result += fmt.Sprintf("kernel,tainted,%d\n", info.Tainted)
+	result += fmt.Sprintf("kernel,cmdline,%s\n", csvField(info.CommandLine))
+	for _, f := range info.TaintFlags {
+		result += fmt.Sprintf("taint,%s,%s\n", f.Letter, csvField(f.Description))
+	}
+	for _, p := range info.Parameters {
+		result += fmt.Sprintf("param,%s,%s\n", csvField(p.Key), csvField(p.Value))
+	}
+	for i, arg := range info.InitArgs {
+		result += fmt.Sprintf("init_arg,%d,%s\n", i, csvField(arg))
+	}
+	return result
+}
+
+func formatTimeCSV(info *models.TimeInfo) string {
+	result := "timezone,utc_offset,utc,local,clocksource,ntp_synchronized,ntp_state,offset_ms,frequency_ppm,max_error_ms,est_error_ms,rtc_time,rtc_skew_seconds\n"
+	result += fmt.Sprintf("%s,%s,%s,%s,%s,%t,%s,%.3f,%.3f,%.3f,%.3f,%s,%d\n",
+		info.Timezone, info.UTCOffset, info.UTC, info.Local, info.Clocksource,
+		info.NTP.Synchronized, csvField(info.NTP.State), info.NTP.OffsetMs, info.NTP.FrequencyPPM,
+		info.NTP.MaxErrorMs, info.NTP.EstErrorMs, info.RTC.Time, info.RTC.SkewSeconds)
+	return result
+}
+
+func formatCgroupsCSV(info *models.CgroupsInfo) string {
+	result := "path,memory_current_mb,memory_max_mb,cpu_usage_sec,throttled_sec,cpu_quota_cpus,io_read_mb,io_write_mb,pids_current,pids_max\n"
+	if info.Root == nil {
+		return result
+	}
+
+	optional := func(v *float64) string {
+		if v == nil {
+			return ""
+		}
+		return fmt.Sprintf("%.2f", *v)
+	}
+
+	var walk func(node *models.CgroupNode)
+	walk = func(node *models.CgroupNode) {
+		pidsMax := ""
+		if node.PidsMax != nil {
+			pidsMax = fmt.Sprintf("%d", *node.PidsMax)
+		}
+		result += fmt.Sprintf("%s,%.2f,%s,%.2f,%.2f,%s,%.2f,%.2f,%d,%s\n",
+			csvField(node.Path), node.MemoryCurrentMB, optional(node.MemoryMaxMB), node.CPUUsageSec, node.ThrottledSec,
+			optional(node.CPUQuotaCPUs), node.IOReadMB, node.IOWriteMB, node.PidsCurrent, pidsMax)
+		for _, child := range node.Children {
+			walk(child)
+		}
+	}
+	walk(info.Root)
+
+	return result
+}
+
+func formatContainersCSV(info *models.ContainersInfo) string {
+	result := "id,runtime,pod_uid,cgroup_path,pids,commands,cpu_usage_sec,throttled_sec,cpu_quota_cpus,memory_current_mb,memory_max_mb,pids_current,pids_max\n"
+	for _, c := range info.Containers {
+		pids := make([]string, len(c.PIDs))
+		for i, pid := range c.PIDs {
+			pids[i] = fmt.Sprintf("%d", pid)
+		}
+		quota, memLimit, pidsMax := "", "", ""
+		if c.CPUQuotaCPUs != nil {
+			quota = fmt.Sprintf("%.2f", *c.CPUQuotaCPUs)
+		}
+		if c.MemoryMaxMB != nil {
+			memLimit = fmt.Sprintf("%.2f", *c.MemoryMaxMB)
+		}
+		if c.PidsMax != nil {
+			pidsMax = fmt.Sprintf("%d", *c.PidsMax)
+		}
+		result += fmt.Sprintf("%s,%s,%s,%s,%s,%s,%.2f,%.2f,%s,%.2f,%s,%d,%s\n",
+			c.ID, c.Runtime, c.PodUID, csvField(c.CgroupPath), strings.Join(pids, ";"), csvField(strings.Join(c.Commands, ";")),
+			c.CPUUsageSec, c.ThrottledSec, quota, c.MemoryCurrentMB, memLimit, c.PidsCurrent, pidsMax)
+	}
+	return result
+}
+
+func formatDockerCSV(info *models.DockerInfo) string {
+	result := "id,name,image,state,status,ports,labels,cpu_percent,memory_usage_mb,memory_limit_mb,memory_percent,net_rx_mb,net_tx_mb,block_read_mb,block_write_mb\n"
+	for _, c := range info.Containers {
+		cpu := ""
+		if c.CPUPercent != nil {
+			cpu = fmt.Sprintf("%.2f", *c.CPUPercent)
+		}
+		labels := make([]string, 0, len(c.Labels))
+		for _, k := range sortedKeys(c.Labels) {
+			labels = append(labels, k+"="+c.Labels[k])
+		}
+		result += fmt.Sprintf("%s,%s,%s,%s,%s,%s,%s,%s,%.2f,%.2f,%.2f,%.2f,%.2f,%.2f,%.2f\n",
+			c.ID, c.Name, csvField(c.Image), c.State, csvField(c.Status), csvField(strings.Join(c.Ports, ";")),
+			csvField(strings.Join(labels, ";")), cpu, c.MemoryUsageMB, c.MemoryLimitMB, c.MemoryPercent,
+			c.NetRxMB, c.NetTxMB, c.BlockReadMB, c.BlockWriteMB)
+	}
+	return result
+}
+
+func formatVirtCSV(info *models.VirtInfo) string {
+	result := "environment,virtual_machine,hypervisor,container,orchestrator,wsl,chroot,evidence\n"
+	result += fmt.Sprintf("%s,%t,%s,%s,%s,%s,%t,%s\n",
+		info.Environment, info.VirtualMachine, info.Hypervisor, csvField(info.Container),
+		info.Orchestrator, info.WSL, info.Chroot, csvField(strings.Join(info.Evidence, "; ")))
+	return result
+}
+
+func formatHardwareCSV(info *models.HardwareInfo) string {
+	result := "field,value,restricted\n"
+	fields := []struct{ name, value string }{
+		{"system_vendor", info.SystemVendor},
+		{"product_name", info.ProductName},
+		{"product_version", info.ProductVersion},
+		{"product_serial", info.ProductSerial},
+		{"product_uuid", info.ProductUUID},
+		{"board_vendor", info.BoardVendor},
+		{"board_name", info.BoardName},
+		{"bios_vendor", info.BIOSVendor},
+		{"bios_version", info.BIOSVersion},
+		{"bios_date", info.BIOSDate},
+		{"chassis_type", info.ChassisType},
+		{"chassis_asset_tag", info.ChassisAssetTag},
+	}
+	for _, f := range fields {
+		result += fmt.Sprintf("%s,%s,%t\n", f.name, csvField(f.value), containsString(info.Restricted, f.name))
+	}
+	return result
+}
+
+func formatDevicesCSV(info *models.DevicesInfo) string {
+	result := "bus,address,parent,class,class_name,vendor_id,device_id,vendor_name,device_name,subsystem_vendor_id,subsystem_device_id,subsystem_name,driver,numa_node,link_speed,link_width\n"
+	for _, d := range info.PCI {
+		numa := ""
+		if d.NUMANode != nil {
+			numa = fmt.Sprintf("%d", *d.NUMANode)
+		}
+		result += fmt.Sprintf("pci,%s,%s,%s,%s,%s,%s,%s,%s,%s,%s,%s,%s,%s,%s,%s\n",
+			d.Address, d.Parent, d.Class, csvField(d.ClassName), d.VendorID, d.DeviceID,
+			csvField(d.VendorName), csvField(d.DeviceName), d.SubsystemVendorID, d.SubsystemDeviceID,
+			csvField(d.SubsystemName), d.Driver, numa, csvField(d.LinkSpeed), d.LinkWidth)
+	}
+	for _, d := range info.USB {
+		vendor, product := d.VendorName, d.ProductName
+		if vendor == "" {
+			vendor = d.Manufacturer
+		}
+		if product == "" {
+			product = d.Product
+		}
+		result += fmt.Sprintf("usb,%s,%s,%s,%s,%s,%s,%s,%s,,,,%s,,%s,\n",
+			d.Address, d.Parent, d.Class, csvField(d.ClassName), d.VendorID, d.ProductID,
+			csvField(vendor), csvField(product), d.Driver, d.Speed)
+	}
+	return result
+}
+
+func formatSensorsCSV(info *models.SensorsInfo) string {
+	optional := func(v *float64) string {
+		if v == nil {
+			return ""
+		}
+		return fmt.Sprintf("%g", *v)
+	}
+
+	result := "source,device,sensor,type,label,value,unit,min,max,crit\n"
+	for _, z := range info.ThermalZones {
+		result += fmt.Sprintf("thermal,%s,temp,temp,%s,%s,°C,,,\n", z.Name, csvField(z.Type), optional(z.TempC))
+		for i, trip := range z.Trips {
+			result += fmt.Sprintf("thermal,%s,trip_point_%d,trip,%s,%g,°C,,,\n", z.Name, i, trip.Type, trip.TempC)
+		}
+	}
+	for _, chip := range info.Hwmon {
+		for _, s := range chip.Sensors {
+			result += fmt.Sprintf("hwmon,%s/%s,%s,%s,%s,%g,%s,%s,%s,%s\n",
+				chip.Hwmon, chip.Name, s.Sensor, s.Type, csvField(s.Label), s.Value, s.Unit,
+				optional(s.Min), optional(s.Max), optional(s.Crit))
+		}
+	}
+	result += fmt.Sprintf("throttle,cpu,core_throttle_count,counter,,%d,,,,\n", info.CoreThrottleCount)
+	result += fmt.Sprintf("throttle,cpu,package_throttle_count,counter,,%d,,,,\n", info.PackageThrottleCount)
+	return result
+}
+
+func formatNamespacesCSV(info *models.NamespacesInfo) string {
+	result := "type,inode,root,processes,example_pid,command,owner_user_ns\n"
+	for _, ns := range info.Namespaces {
+		result += fmt.Sprintf("%s,%d,%t,%d,%d,%s,%d\n",
+			ns.Type, ns.Inode, ns.Root, ns.Processes, ns.ExamplePID, csvField(ns.Command), ns.OwnerUserNS)
+	}
+	return result
+}
+
+func formatIPCCSV(info *models.IPCInfo) string {
+	result := "type,key,id,path,owner,perms,size_bytes,rss_bytes,attached,creator_pid,last_pid,orphaned,nsems,messages\n"
+	for _, s := range info.SharedMemory {
+		result += fmt.Sprintf("shm,%s,%d,,%s,%s,%d,%d,%d,%d,%d,%t,,\n",
+			s.Key, s.ID, csvField(s.Owner), s.Perms, s.SizeBytes, s.RSSBytes, s.Attached,
+			s.CreatorPID, s.LastPID, s.Orphaned)
+	}
+	for _, s := range info.Semaphores {
+		result += fmt.Sprintf("sem,%s,%d,,%s,%s,,,,,,,%d,\n", s.Key, s.ID, csvField(s.Owner), s.Perms, s.NSems)
+	}
+	for _, q := range info.MessageQueues {
+		result += fmt.Sprintf("msg,%s,%d,,%s,%s,%d,,,%d,%d,,,%d\n",
+			q.Key, q.ID, csvField(q.Owner), q.Perms, q.Bytes, q.LastSendPID, q.LastRecvPID, q.Messages)
+	}
+	for _, f := range info.DevShm.Files {
+		result += fmt.Sprintf("posix,,,%s,%s,,%d,%d,%d,,,%t,,\n",
+			csvField(f.Path), csvField(f.Owner), f.SizeBytes, f.AllocatedBytes, f.Processes, f.Processes == 0)
+	}
+	result += fmt.Sprintf("dev_shm,,,%s,,,%d,%d,,,,,,\n", csvField(info.DevShm.Path), info.DevShm.TotalBytes, info.DevShm.UsedBytes)
+	return result
+}
+
+// csvField quotes free-form values that may contain commas or quotes
+func csvField(value string) string {
+	if !strings.ContainsAny(value, ",\"\n") {
+		return value
+	}
+	return `"` + strings.ReplaceAll(value, `"`, `""`) + `"`
+}
+
+// sortedKeys returns map keys in stable order for deterministic output
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
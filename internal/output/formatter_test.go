@@ -3,6 +3,7 @@ package output
 import (
 	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/example/sysinfo-cli/internal/models"
 )
@@ -89,3 +90,208 @@ func TestPrettyJSON(t *testing.T) {
 		t.Errorf("Expected pretty-printed JSON with newlines")
 	}
 }
+
+func TestFormatterDUCSVQuotesPaths(t *testing.T) {
+	formatter := NewFormatter("csv", false)
+
+	info := &models.DUInfo{
+		TopDirectories: []models.DUEntry{{Path: "/tmp/dut/a,b", Type: "dir", AllocatedMB: 0.01}},
+		TopFiles:       []models.DUEntry{{Path: `/tmp/dut/say "hi"`, Type: "file"}},
+	}
+	output, err := formatter.Format(info, "du")
+	if err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+
+	if !strings.Contains(output, "dir,\"/tmp/dut/a,b\",0.00,0.01\n") {
+		t.Errorf("Expected comma in path to be quoted, got %q", output)
+	}
+	if !strings.Contains(output, `file,"/tmp/dut/say ""hi""",0.00,0.00`) {
+		t.Errorf("Expected quotes in path to be doubled, got %q", output)
+	}
+}
+
+func TestFormatterSwapTable(t *testing.T) {
+	formatter := NewFormatter("table", false)
+
+	info := &models.SwapInfo{
+		Devices: []models.SwapDevice{{Filename: "/dev/zram0", Type: "partition", SizeMB: 4096, Priority: 100}},
+		Zram:    []models.ZramDevice{{Name: "zram0", Algorithm: "zstd", CompressionRatio: 3.5}},
+		Zswap:   models.ZswapInfo{Available: true, Enabled: true, Parameters: map[string]string{"compressor": "lz4"}},
+	}
+
+	output, err := formatter.Format(info, "swap")
+	if err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+
+	for _, want := range []string{"/dev/zram0", "zstd", "3.50x", "enabled", "compressor"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected %q in output, got: %s", want, output)
+		}
+	}
+}
+
+func TestFormatterSwapCSVQuotesFilename(t *testing.T) {
+	formatter := NewFormatter("csv", false)
+
+	info := &models.SwapInfo{Devices: []models.SwapDevice{{Filename: "/swap/a,b", Type: "file", SizeMB: 512, Priority: -2}}}
+	output, err := formatter.Format(info, "swap")
+	if err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+
+	if !strings.Contains(output, "\"/swap/a,b\",file,512.00,0.00,-2\n") {
+		t.Errorf("Expected comma in swap filename to be quoted, got %q", output)
+	}
+}
+
+func TestFormatterPressureCSVQuotesCgroupPath(t *testing.T) {
+	formatter := NewFormatter("csv", false)
+
+	info := &models.PressureInfo{Cgroups: []models.CgroupPressure{{
+		Path:      "/system.slice/app,1.service",
+		Resources: []models.PressureResource{{Resource: "cpu"}},
+	}}}
+	output, err := formatter.Format(info, "pressure")
+	if err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+
+	if !strings.Contains(output, "\"/system.slice/app,1.service\",cpu,some,") {
+		t.Errorf("Expected comma in cgroup path to be quoted, got %q", output)
+	}
+}
+
+func TestFormatterDiskForecastTable(t *testing.T) {
+	formatter := NewFormatter("table", false)
+
+	hours := 26.5
+	forecasts := []models.DiskForecast{
+		{MountPoint: "/", UsagePercent: 90, ThresholdPercent: 100, FillRateGBPerHour: 0.5, HoursToThreshold: &hours, Status: "filling"},
+		{MountPoint: "/home", UsagePercent: 40, ThresholdPercent: 100, Status: "not filling"},
+	}
+
+	output, err := formatter.Format(forecasts, "disk")
+	if err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+
+	for _, want := range []string{"Disk Forecast", "1d 2h", "not filling"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected %q in output, got: %s", want, output)
+		}
+	}
+}
+
+func TestFormatterModulesTable(t *testing.T) {
+	formatter := NewFormatter("table", false)
+
+	info := &models.ModulesInfo{
+		Available: true,
+		Tainted:   1,
+		Modules: []models.KernelModule{
+			{Name: "nvidia", SizeBytes: 56717312, RefCount: 2, UsedBy: []string{"nvidia_uvm"}, State: "Live", Taint: "POE", Version: "550.54.14"},
+			{Name: "ext4", SizeBytes: 1105920, RefCount: 1, UsedBy: []string{}, State: "Live"},
+		},
+	}
+
+	output, err := formatter.Format(info, "modules")
+	if err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+
+	for _, want := range []string{"Tainted:       1", "POE", "550.54.14", "nvidia_uvm", "ext4"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected %q in output, got: %s", want, output)
+		}
+	}
+}
+
+func TestFormatterHardwareRestricted(t *testing.T) {
+	info := &models.HardwareInfo{
+		Available:       true,
+		SystemVendor:    "LENOVO",
+		ProductName:     "21CB",
+		ChassisType:     "Notebook",
+		ChassisTypeCode: 10,
+		Restricted:      []string{"product_serial", "product_uuid"},
+	}
+
+	table, err := NewFormatter("table", false).Format(info, "hardware")
+	if err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+	for _, want := range []string{"LENOVO", "Notebook (10)", "Serial:        (restricted, run as root)"} {
+		if !strings.Contains(table, want) {
+			t.Errorf("Expected %q in output, got: %s", want, table)
+		}
+	}
+
+	csv, err := NewFormatter("csv", false).Format(info, "hardware")
+	if err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+	if !strings.Contains(csv, "product_uuid,,true") || !strings.Contains(csv, "system_vendor,LENOVO,false") {
+		t.Errorf("Expected restricted column in CSV, got: %s", csv)
+	}
+}
+
+func TestFormatterSensorsTable(t *testing.T) {
+	temp, crit, fanMin, fanCrit := 46.0, 100.0, 300.0, 2000.0
+	info := &models.SensorsInfo{
+		ThermalZones: []models.ThermalZone{{Name: "thermal_zone0", Type: "x86_pkg_temp", TempC: &temp, Trips: []models.TripPoint{{Type: "passive", TempC: 95}}}},
+		Hwmon: []models.HwmonChip{{Hwmon: "hwmon1", Name: "coretemp", Device: "coretemp.0", Sensors: []models.SensorReading{
+			{Sensor: "temp1", Type: "temp", Label: "Package id 0", Value: 46, Unit: "°C", Crit: &crit},
+			{Sensor: "fan1", Type: "fan", Label: "CPU Fan", Value: 1250, Unit: "RPM", Min: &fanMin, Crit: &fanCrit},
+		}}},
+		PackageThrottleCount: 4,
+	}
+
+	output, err := NewFormatter("table", false).Format(info, "sensors")
+	if err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+
+	for _, want := range []string{"passive 95.0°C", "coretemp (hwmon1, coretemp.0)", "100.0 °C", "1250 RPM", "Package Events:  4"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected %q in output, got: %s", want, output)
+		}
+	}
+
+	// Both rows end in an 8-character crit cell ("100.0 °C", "2000 RPM"), so
+	// the multi-byte "°C" must not change their width
+	var temp1, fan1 string
+	for _, line := range strings.Split(output, "\n") {
+		if strings.Contains(line, "temp1") {
+			temp1 = line
+		}
+		if strings.Contains(line, "fan1") {
+			fan1 = line
+		}
+	}
+	if utf8.RuneCountInString(temp1) != utf8.RuneCountInString(fan1) {
+		t.Errorf("Expected aligned columns:\n%s\n%s", temp1, fan1)
+	}
+}
+
+func TestFormatterCgroupCSVOnlyInContainerScope(t *testing.T) {
+	limits := &models.CgroupLimits{Available: true, Path: "/user.slice/user-1000.slice/session-2.scope"}
+	formatter := NewFormatter("csv", false)
+
+	host, err := formatter.Format(&models.MemoryInfo{TotalGB: 16, Scope: "host", Cgroup: limits}, "memory")
+	if err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+	if lines := strings.Split(strings.TrimSpace(host), "\n"); len(lines) != 2 {
+		t.Errorf("Expected the baseline header and row for host scope, got: %s", host)
+	}
+
+	container, err := formatter.Format(&models.CPUInfo{Cores: 2, Scope: "container", Cgroup: limits}, "cpu")
+	if err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+	if !strings.Contains(container, "\n\nscope,cgroup,") {
+		t.Errorf("Expected a cgroup section for container scope, got: %s", container)
+	}
+}
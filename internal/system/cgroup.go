@@ -0,0 +1,46 @@
+package system
+
+import (
+	"os"
+	"strings"
+)
+
+const (
+	procMountInfoPath   = "/proc/self/mountinfo"
+	defaultCgroup2Mount = "/sys/fs/cgroup"
+)
+
+// cgroup2Root returns where the cgroup v2 unified hierarchy is mounted
+// Hybrid hosts mount it at /sys/fs/cgroup/unified; pure v2 at /sys/fs/cgroup
+func cgroup2Root() string {
+	data, err := os.ReadFile(procMountInfoPath)
+	if err != nil {
+		return defaultCgroup2Mount
+	}
+	if mount := parseCgroup2Mount(string(data)); mount != "" {
+		return mount
+	}
+	return defaultCgroup2Mount
+}
+
+// parseCgroup2Mount finds the first cgroup2 mount point in mountinfo content
+// Format: id parent major:minor root mountpoint options [optional...] - fstype source superopts
+func parseCgroup2Mount(data string) string {
+	for _, line := range strings.Split(data, "\n") {
+		sep := strings.Index(line, " - ")
+		if sep < 0 {
+			continue
+		}
+
+		fields := strings.Fields(line[:sep])
+		post := strings.Fields(line[sep+3:])
+		if len(fields) < 5 || len(post) < 1 {
+			continue
+		}
+
+		if post[0] == "cgroup2" {
+			return unescapeOctal(fields[4])
+		}
+	}
+	return ""
+}
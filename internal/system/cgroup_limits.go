@@ -0,0 +1,237 @@
+package system
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+const procSelfCgroupPath = "/proc/self/cgroup"
+
+// Views for --scope
+const (
+	ScopeContainer = "container"
+	ScopeHost      = "host"
+)
+
+// GetCgroupLimits returns the effective cgroup v2 limits for this process
+func GetCgroupLimits() *models.CgroupLimits {
+	data, err := os.ReadFile(procSelfCgroupPath)
+	if err != nil {
+		return &models.CgroupLimits{}
+	}
+
+	path, ok := parseSelfCgroup2Path(string(data))
+	if !ok {
+		return &models.CgroupLimits{}
+	}
+	return readCgroupLimits(cgroup2Root(), path)
+}
+
+// parseSelfCgroup2Path returns the unified hierarchy path from /proc/self/cgroup
+// Format: hierarchy-id:controllers:path, where cgroup v2 is "0::/path"
+func parseSelfCgroup2Path(data string) (string, bool) {
+	for _, line := range strings.Split(data, "\n") {
+		if path, ok := strings.CutPrefix(line, "0::"); ok {
+			return path, true
+		}
+	}
+	return "", false
+}
+
+// readCgroupLimits reads usage from the process's cgroup and the tightest
+// limits found walking up to the mount root, since ancestors' limits apply too
+func readCgroupLimits(mount, path string) *models.CgroupLimits {
+	limits := &models.CgroupLimits{Path: path}
+
+	mount = filepath.Clean(mount)
+	leaf := filepath.Join(mount, path)
+	if fi, err := os.Stat(leaf); err != nil || !fi.IsDir() {
+		return limits
+	}
+
+	// The root cgroup has no limit files, and on hybrid hosts the
+	// controllers may be bound to v1 instead; either way nothing applies
+	for _, name := range []string{"memory.max", "cpu.max", "pids.max"} {
+		if _, err := os.Stat(filepath.Join(leaf, name)); err == nil {
+			limits.Available = true
+			break
+		}
+	}
+	if !limits.Available {
+		return limits
+	}
+
+	for dir := leaf; ; dir = filepath.Dir(dir) {
+		if max, ok := readCgroupMax(filepath.Join(dir, "memory.max")); ok {
+			gb := bytesToGB(max)
+			if limits.MemoryMaxGB == nil || gb < *limits.MemoryMaxGB {
+				limits.MemoryMaxGB = &gb
+			}
+		}
+
+		if max, ok := readCgroupMax(filepath.Join(dir, "pids.max")); ok {
+			if limits.PidsMax == nil || max < *limits.PidsMax {
+				limits.PidsMax = &max
+			}
+		}
+
+		if quota, period, ok := readCPUMax(filepath.Join(dir, "cpu.max")); ok {
+			cpus := float64(quota) / float64(period)
+			if limits.QuotaCPUs == nil || cpus < *limits.QuotaCPUs {
+				limits.QuotaCPUs = &cpus
+				limits.CPUQuotaUs = quota
+				limits.CPUPeriodUs = period
+			}
+		}
+
+		if dir == mount || len(dir) <= len(mount) {
+			break
+		}
+	}
+
+	if current, err := readSysfsUint(filepath.Join(leaf, "memory.current")); err == nil {
+		limits.MemoryCurrentGB = bytesToGB(current)
+	}
+	limits.PidsCurrent, _ = readSysfsUint(filepath.Join(leaf, "pids.current"))
+
+	events := readFlatKeyed(filepath.Join(leaf, "memory.events"))
+	limits.OOMEvents = events["oom"]
+	limits.OOMKillEvents = events["oom_kill"]
+
+	stat := readFlatKeyed(filepath.Join(leaf, "cpu.stat"))
+	limits.NrPeriods = stat["nr_periods"]
+	limits.NrThrottled = stat["nr_throttled"]
+	limits.ThrottledUsec = stat["throttled_usec"]
+	if limits.NrPeriods > 0 {
+		limits.ThrottledPercent = float64(limits.NrThrottled) / float64(limits.NrPeriods) * 100
+	}
+
+	if cpuset, err := readSysfsString(filepath.Join(leaf, "cpuset.cpus.effective")); err == nil && cpuset != "" {
+		limits.CPUSet = cpuset
+	}
+
+	limits.EffectiveCPUs = effectiveCPUs(limits)
+
+	return limits
+}
+
+// effectiveCPUs combines the CPU quota with the cpuset size, whichever is lower
+func effectiveCPUs(limits *models.CgroupLimits) *float64 {
+	var cpus *float64
+	if limits.QuotaCPUs != nil {
+		v := *limits.QuotaCPUs
+		cpus = &v
+	}
+	if limits.CPUSet != "" {
+		if n := float64(len(parseCPUList(limits.CPUSet))); n > 0 && (cpus == nil || n < *cpus) {
+			cpus = &n
+		}
+	}
+	return cpus
+}
+
+// readCgroupMax reads a limit file that holds a number or "max"
+// ok is false when the file is missing or the value is unlimited
+func readCgroupMax(path string) (uint64, bool) {
+	value, err := readSysfsString(path)
+	if err != nil || value == "max" {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(value, 10, 64)
+	return n, err == nil
+}
+
+// readCPUMax parses cpu.max
+// Format: "$MAX $PERIOD", where $MAX may be "max"
+func readCPUMax(path string) (quota, period uint64, ok bool) {
+	fields := readFields(path)
+	if len(fields) != 2 || fields[0] == "max" {
+		return 0, 0, false
+	}
+	quota, err1 := strconv.ParseUint(fields[0], 10, 64)
+	period, err2 := strconv.ParseUint(fields[1], 10, 64)
+	if err1 != nil || err2 != nil || period == 0 {
+		return 0, 0, false
+	}
+	return quota, period, true
+}
+
+// readFlatKeyed parses cgroup "key value" files such as memory.events and cpu.stat
+func readFlatKeyed(path string) map[string]uint64 {
+	values := make(map[string]uint64)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return values
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		if v, err := strconv.ParseUint(fields[1], 10, 64); err == nil {
+			values[fields[0]] = v
+		}
+	}
+	return values
+}
+
+// applyMemoryScope attaches cgroup limits and, for the container scope with
+// a memory limit in force, makes the cgroup view primary with host values nested
+func applyMemoryScope(info *models.MemoryInfo, limits *models.CgroupLimits, scope string) {
+	info.Scope = ScopeHost
+	if !limits.Available {
+		return
+	}
+	info.Cgroup = limits
+
+	if scope != ScopeContainer || limits.MemoryMaxGB == nil {
+		return
+	}
+
+	host := *info
+	host.Cgroup = nil
+	host.Scope = ""
+	info.Host = &host
+
+	info.Scope = ScopeContainer
+	info.TotalGB = *limits.MemoryMaxGB
+	info.UsedGB = limits.MemoryCurrentGB
+	info.AvailableGB = math.Max(info.TotalGB-info.UsedGB, 0)
+	info.UsagePercent = 0
+	if info.TotalGB > 0 {
+		info.UsagePercent = info.UsedGB / info.TotalGB * 100
+	}
+}
+
+// applyCPUScope is the CPU equivalent of applyMemoryScope; cores become the
+// effective CPU count rounded up, capped at the host's
+func applyCPUScope(info *models.CPUInfo, limits *models.CgroupLimits, scope string) {
+	info.Scope = ScopeHost
+	if !limits.Available {
+		return
+	}
+	info.Cgroup = limits
+
+	if scope != ScopeContainer || limits.EffectiveCPUs == nil {
+		return
+	}
+
+	host := *info
+	host.Cgroup = nil
+	host.Scope = ""
+	info.Host = &host
+
+	cores := int(math.Ceil(*limits.EffectiveCPUs))
+	if cores < 1 {
+		cores = 1
+	}
+	info.Scope = ScopeContainer
+	info.Cores = min(cores, host.Cores)
+	info.Threads = min(cores, host.Threads)
+}
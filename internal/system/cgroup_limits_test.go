@@ -0,0 +1,98 @@
+package system
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+func cgroupFixture(t *testing.T) string {
+	t.Helper()
+	mount := t.TempDir()
+
+	// The pod-level parent caps memory lower than the container itself
+	writeFile(t, filepath.Join(mount, "kubepods/pod1/memory.max"), "1073741824\n")
+	writeFile(t, filepath.Join(mount, "kubepods/pod1/cpu.max"), "max 100000\n")
+	writeFile(t, filepath.Join(mount, "kubepods/pod1/app/memory.max"), "2147483648\n")
+	writeFile(t, filepath.Join(mount, "kubepods/pod1/app/memory.current"), "536870912\n")
+	writeFile(t, filepath.Join(mount, "kubepods/pod1/app/memory.events"), "low 0\nhigh 0\nmax 12\noom 3\noom_kill 2\n")
+	writeFile(t, filepath.Join(mount, "kubepods/pod1/app/cpu.max"), "150000 100000\n")
+	writeFile(t, filepath.Join(mount, "kubepods/pod1/app/cpu.stat"), "usage_usec 900\nnr_periods 200\nnr_throttled 50\nthrottled_usec 2500000\n")
+	writeFile(t, filepath.Join(mount, "kubepods/pod1/app/cpuset.cpus.effective"), "0-3\n")
+	writeFile(t, filepath.Join(mount, "kubepods/pod1/app/pids.max"), "max\n")
+	writeFile(t, filepath.Join(mount, "kubepods/pod1/app/pids.current"), "17\n")
+	return mount
+}
+
+func TestParseSelfCgroup2Path(t *testing.T) {
+	path, ok := parseSelfCgroup2Path("4:memory:/docker/abc\n0::/kubepods/pod1/app\n")
+	if !ok || path != "/kubepods/pod1/app" {
+		t.Errorf("Expected unified path, got %q (%t)", path, ok)
+	}
+
+	if _, ok := parseSelfCgroup2Path("4:memory:/docker/abc\n"); ok {
+		t.Errorf("Expected no unified path on a pure cgroup v1 host")
+	}
+}
+
+func TestReadCgroupLimits(t *testing.T) {
+	limits := readCgroupLimits(cgroupFixture(t), "/kubepods/pod1/app")
+
+	if !limits.Available {
+		t.Fatalf("Expected limits to be available")
+	}
+	if limits.MemoryMaxGB == nil || *limits.MemoryMaxGB != 1 {
+		t.Errorf("Expected the parent's 1 GB limit to apply, got %v", limits.MemoryMaxGB)
+	}
+	if limits.MemoryCurrentGB != 0.5 || limits.OOMEvents != 3 || limits.OOMKillEvents != 2 {
+		t.Errorf("Unexpected memory usage %+v", limits)
+	}
+	if limits.QuotaCPUs == nil || *limits.QuotaCPUs != 1.5 || limits.CPUQuotaUs != 150000 || limits.CPUPeriodUs != 100000 {
+		t.Errorf("Unexpected CPU quota %+v", limits)
+	}
+	if limits.EffectiveCPUs == nil || *limits.EffectiveCPUs != 1.5 {
+		t.Errorf("Expected 1.5 effective CPUs, got %v", limits.EffectiveCPUs)
+	}
+	if limits.ThrottledPercent != 25 || limits.ThrottledUsec != 2500000 {
+		t.Errorf("Unexpected throttling %+v", limits)
+	}
+	if limits.PidsMax != nil || limits.PidsCurrent != 17 {
+		t.Errorf("Expected unlimited pids with 17 current, got %v / %d", limits.PidsMax, limits.PidsCurrent)
+	}
+
+	if limits := readCgroupLimits(t.TempDir(), "/missing"); limits.Available {
+		t.Errorf("Expected unavailable limits for a missing cgroup")
+	}
+
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "cpu.stat"), "usage_usec 900\n")
+	if limits := readCgroupLimits(root, "/"); limits.Available {
+		t.Errorf("Expected unavailable limits in the root cgroup")
+	}
+}
+
+func TestApplyScope(t *testing.T) {
+	limits := readCgroupLimits(cgroupFixture(t), "/kubepods/pod1/app")
+
+	mem := &models.MemoryInfo{TotalGB: 64, UsedGB: 32, AvailableGB: 32, UsagePercent: 50}
+	applyMemoryScope(mem, limits, ScopeContainer)
+	if mem.Scope != ScopeContainer || mem.TotalGB != 1 || mem.UsedGB != 0.5 || mem.UsagePercent != 50 {
+		t.Errorf("Expected container memory view, got %+v", mem)
+	}
+	if mem.Host == nil || mem.Host.TotalGB != 64 || mem.Cgroup == nil {
+		t.Errorf("Expected host values alongside, got %+v", mem.Host)
+	}
+
+	cpu := &models.CPUInfo{Cores: 16, Threads: 32}
+	applyCPUScope(cpu, limits, ScopeContainer)
+	if cpu.Cores != 2 || cpu.Threads != 2 || cpu.Host == nil || cpu.Host.Cores != 16 {
+		t.Errorf("Expected 2 effective cores with host 16, got %+v", cpu)
+	}
+
+	cpu = &models.CPUInfo{Cores: 16, Threads: 32}
+	applyCPUScope(cpu, limits, ScopeHost)
+	if cpu.Scope != ScopeHost || cpu.Cores != 16 || cpu.Host != nil || cpu.Cgroup == nil {
+		t.Errorf("Expected host view with cgroup limits attached, got %+v", cpu)
+	}
+}
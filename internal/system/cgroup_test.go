@@ -0,0 +1,39 @@
+package system
+
+import (
+	"testing"
+)
+
+func TestParseCgroup2Mount(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{
+			name: "Hybrid hierarchy",
+			data: `25 30 0:23 / /sys rw,nosuid,nodev,noexec,relatime shared:7 - sysfs sysfs rw
+32 25 0:27 / /sys/fs/cgroup ro,nosuid,nodev,noexec shared:9 - tmpfs tmpfs ro,mode=755
+42 32 0:38 / /sys/fs/cgroup/unified rw,relatime - cgroup2 cgroup2 rw`,
+			expected: "/sys/fs/cgroup/unified",
+		},
+		{
+			name:     "Unified hierarchy",
+			data:     `35 24 0:30 / /sys/fs/cgroup rw,nosuid,nodev,noexec,relatime shared:9 - cgroup2 cgroup2 rw,nsdelegate`,
+			expected: "/sys/fs/cgroup",
+		},
+		{
+			name:     "No cgroup2",
+			data:     `25 30 0:23 / /sys rw,relatime - sysfs sysfs rw`,
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseCgroup2Mount(tt.data); got != tt.expected {
+				t.Errorf("parseCgroup2Mount = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
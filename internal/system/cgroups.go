@@ -0,0 +1,144 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+// CgroupSortKeys are the values accepted by --sort for the cgroups command
+var CgroupSortKeys = []string{"cpu", "memory", "io", "pids", "throttled", "name"}
+
+// GetCgroupsInfo walks the cgroup v2 hierarchy and returns it as a tree
+// Siblings are ordered by sortBy; maxDepth of 0 means unlimited
+func GetCgroupsInfo(sortBy string, maxDepth int) (*models.CgroupsInfo, error) {
+	mount := cgroup2Root()
+	info := &models.CgroupsInfo{Mount: mount}
+
+	if _, err := os.Stat(filepath.Join(mount, "cgroup.controllers")); err != nil {
+		info.Message = "cgroup v2 unified hierarchy is not mounted"
+		return info, nil
+	}
+	info.Available = true
+
+	root := readCgroupTree(mount, "/", 0, maxDepth)
+	sortCgroupTree(root, sortBy)
+	info.Root = root
+
+	return info, nil
+}
+
+// readCgroupTree reads the cgroup at mount+path and its descendants
+func readCgroupTree(mount, path string, depth, maxDepth int) *models.CgroupNode {
+	dir := filepath.Join(mount, path)
+	node := readCgroupNode(dir)
+	node.Path = path
+	node.Name = filepath.Base(path)
+	node.Children = make([]*models.CgroupNode, 0)
+
+	if maxDepth > 0 && depth >= maxDepth {
+		return node
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return node
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			child := readCgroupTree(mount, filepath.Join(path, entry.Name()), depth+1, maxDepth)
+			node.Children = append(node.Children, child)
+		}
+	}
+
+	return node
+}
+
+// readCgroupNode reads usage and limits from a single cgroup directory
+// Files for controllers not enabled in the parent's subtree_control are absent
+func readCgroupNode(dir string) *models.CgroupNode {
+	node := &models.CgroupNode{}
+
+	if current, err := readSysfsUint(filepath.Join(dir, "memory.current")); err == nil {
+		node.MemoryCurrentMB = bytesToMB(current)
+	}
+	if max, ok := readCgroupMax(filepath.Join(dir, "memory.max")); ok {
+		mb := bytesToMB(max)
+		node.MemoryMaxMB = &mb
+	}
+
+	stat := readFlatKeyed(filepath.Join(dir, "cpu.stat"))
+	node.CPUUsageSec = float64(stat["usage_usec"]) / 1e6
+	node.ThrottledSec = float64(stat["throttled_usec"]) / 1e6
+	if quota, period, ok := readCPUMax(filepath.Join(dir, "cpu.max")); ok {
+		cpus := float64(quota) / float64(period)
+		node.CPUQuotaCPUs = &cpus
+	}
+
+	if data, err := os.ReadFile(filepath.Join(dir, "io.stat")); err == nil {
+		read, written := parseIOStat(string(data))
+		node.IOReadMB = bytesToMB(read)
+		node.IOWriteMB = bytesToMB(written)
+	}
+
+	node.PidsCurrent, _ = readSysfsUint(filepath.Join(dir, "pids.current"))
+	if max, ok := readCgroupMax(filepath.Join(dir, "pids.max")); ok {
+		node.PidsMax = &max
+	}
+
+	return node
+}
+
+// parseIOStat sums bytes read and written across devices
+// Format: 8:0 rbytes=1459200 wbytes=314773504 rios=192 wios=353 dbytes=0 dios=0
+func parseIOStat(data string) (read, written uint64) {
+	for _, line := range strings.Split(data, "\n") {
+		fields := strings.Fields(line)
+		for _, field := range fields[min(1, len(fields)):] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				continue
+			}
+			n, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				continue
+			}
+			switch key {
+			case "rbytes":
+				read += n
+			case "wbytes":
+				written += n
+			}
+		}
+	}
+	return read, written
+}
+
+// sortCgroupTree orders siblings at every level, largest first (name ascending)
+func sortCgroupTree(node *models.CgroupNode, sortBy string) {
+	sort.SliceStable(node.Children, func(i, j int) bool {
+		a, b := node.Children[i], node.Children[j]
+		switch sortBy {
+		case "memory":
+			return a.MemoryCurrentMB > b.MemoryCurrentMB
+		case "io":
+			return a.IOReadMB+a.IOWriteMB > b.IOReadMB+b.IOWriteMB
+		case "pids":
+			return a.PidsCurrent > b.PidsCurrent
+		case "throttled":
+			return a.ThrottledSec > b.ThrottledSec
+		case "name":
+			return a.Name < b.Name
+		default:
+			return a.CPUUsageSec > b.CPUUsageSec
+		}
+	})
+
+	for _, child := range node.Children {
+		sortCgroupTree(child, sortBy)
+	}
+}
@@ -0,0 +1,77 @@
+package system
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestParseIOStat(t *testing.T) {
+	read, written := parseIOStat("8:0 rbytes=1000 wbytes=2000 rios=1 wios=2 dbytes=0 dios=0\n253:0 rbytes=500 wbytes=0 rios=1 wios=0 dbytes=0 dios=0\n")
+	if read != 1500 || written != 2000 {
+		t.Errorf("Expected 1500/2000 bytes, got %d/%d", read, written)
+	}
+}
+
+func TestReadCgroupTree(t *testing.T) {
+	mount := t.TempDir()
+	writeFile(t, filepath.Join(mount, "cgroup.controllers"), "cpu memory io pids\n")
+	writeFile(t, filepath.Join(mount, "cpu.stat"), "usage_usec 9000000\n")
+
+	writeFile(t, filepath.Join(mount, "system.slice/cpu.stat"), "usage_usec 3000000\nthrottled_usec 0\n")
+	writeFile(t, filepath.Join(mount, "system.slice/memory.current"), "104857600\n")
+
+	writeFile(t, filepath.Join(mount, "system.slice/nginx.service/cpu.stat"), "usage_usec 1000000\nthrottled_usec 500000\n")
+	writeFile(t, filepath.Join(mount, "system.slice/nginx.service/cpu.max"), "50000 100000\n")
+	writeFile(t, filepath.Join(mount, "system.slice/nginx.service/memory.current"), "10485760\n")
+	writeFile(t, filepath.Join(mount, "system.slice/nginx.service/memory.max"), "209715200\n")
+	writeFile(t, filepath.Join(mount, "system.slice/nginx.service/io.stat"), "8:0 rbytes=1048576 wbytes=2097152\n")
+	writeFile(t, filepath.Join(mount, "system.slice/nginx.service/pids.current"), "5\n")
+	writeFile(t, filepath.Join(mount, "system.slice/nginx.service/pids.max"), "max\n")
+
+	writeFile(t, filepath.Join(mount, "system.slice/cron.service/cpu.stat"), "usage_usec 2000000\n")
+	writeFile(t, filepath.Join(mount, "system.slice/cron.service/memory.current"), "52428800\n")
+
+	writeFile(t, filepath.Join(mount, "user.slice/cpu.stat"), "usage_usec 6000000\n")
+
+	root := readCgroupTree(mount, "/", 0, 0)
+	sortCgroupTree(root, "cpu")
+
+	if root.CPUUsageSec != 9 || len(root.Children) != 2 {
+		t.Fatalf("Unexpected root %+v", root)
+	}
+	if root.Children[0].Path != "/user.slice" {
+		t.Errorf("Expected user.slice first by cpu, got %s", root.Children[0].Path)
+	}
+
+	system := root.Children[1]
+	if len(system.Children) != 2 || system.Children[0].Name != "cron.service" {
+		t.Fatalf("Expected cron.service first by cpu under system.slice, got %+v", system.Children)
+	}
+
+	nginx := system.Children[1]
+	if nginx.Path != "/system.slice/nginx.service" || nginx.MemoryCurrentMB != 10 || nginx.MemoryMaxMB == nil || *nginx.MemoryMaxMB != 200 {
+		t.Errorf("Unexpected nginx memory %+v", nginx)
+	}
+	if nginx.CPUQuotaCPUs == nil || *nginx.CPUQuotaCPUs != 0.5 || nginx.ThrottledSec != 0.5 {
+		t.Errorf("Unexpected nginx cpu %+v", nginx)
+	}
+	if nginx.IOReadMB != 1 || nginx.IOWriteMB != 2 || nginx.PidsCurrent != 5 || nginx.PidsMax != nil {
+		t.Errorf("Unexpected nginx io/pids %+v", nginx)
+	}
+
+	sortCgroupTree(root, "memory")
+	if root.Children[0].Path != "/system.slice" || system.Children[0].Name != "cron.service" {
+		t.Errorf("Unexpected memory order")
+	}
+	sortCgroupTree(root, "throttled")
+	if system.Children[0].Name != "nginx.service" {
+		t.Errorf("Expected nginx.service first by throttled time")
+	}
+
+	shallow := readCgroupTree(mount, "/", 0, 1)
+	for _, child := range shallow.Children {
+		if len(child.Children) != 0 {
+			t.Errorf("Expected depth limit to stop at %s", child.Path)
+		}
+	}
+}
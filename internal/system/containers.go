@@ -0,0 +1,259 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+// containerScopePrefixes maps cgroup name prefixes used by the systemd
+// cgroup driver (e.g. docker-<id>.scope) to the runtime that created them
+var containerScopePrefixes = []struct {
+	prefix  string
+	runtime string
+}{
+	{"docker-", "docker"},
+	{"libpod-", "podman"},
+	{"cri-containerd-", "containerd"},
+	{"crio-", "cri-o"},
+	{"containerd-", "containerd"},
+}
+
+// GetContainersInfo groups processes by the container their cgroup belongs to
+// and reads each container's usage and limits, using only /proc and /sys
+func GetContainersInfo(sortBy string) (*models.ContainersInfo, error) {
+	mount := cgroup2Root()
+	info := scanContainers("/proc", mount)
+	if _, err := os.Stat(filepath.Join(mount, "cgroup.controllers")); err != nil {
+		info.Message = "cgroup v2 is not mounted; usage and limits are unavailable"
+	}
+
+	sort.SliceStable(info.Containers, func(i, j int) bool {
+		a, b := info.Containers[i], info.Containers[j]
+		if sortBy == "memory" {
+			return a.MemoryCurrentMB > b.MemoryCurrentMB
+		}
+		return a.CPUUsageSec > b.CPUUsageSec
+	})
+
+	return info, nil
+}
+
+// scanContainers reads /proc/<pid>/cgroup for every process under procRoot
+func scanContainers(procRoot, mount string) *models.ContainersInfo {
+	info := &models.ContainersInfo{
+		Containers: make([]models.ContainerInfo, 0),
+	}
+
+	entries, err := os.ReadDir(procRoot)
+	if err != nil {
+		return info
+	}
+
+	byID := make(map[string]*models.ContainerInfo)
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(procRoot, entry.Name(), "cgroup"))
+		if err != nil {
+			continue
+		}
+
+		ref, ok := findContainer(processCgroupPaths(string(data)))
+		if !ok {
+			continue
+		}
+
+		c, exists := byID[ref.id]
+		if !exists {
+			c = &models.ContainerInfo{
+				ID:         ref.id,
+				Runtime:    ref.runtime,
+				PodUID:     ref.podUID,
+				CgroupPath: ref.cgroupPath,
+				PIDs:       make([]int, 0),
+				Commands:   make([]string, 0),
+			}
+			byID[ref.id] = c
+		}
+
+		c.PIDs = append(c.PIDs, pid)
+		if comm, err := readSysfsString(filepath.Join(procRoot, entry.Name(), "comm")); err == nil && !containsString(c.Commands, comm) {
+			c.Commands = append(c.Commands, comm)
+		}
+	}
+
+	for _, c := range byID {
+		sort.Ints(c.PIDs)
+		sort.Strings(c.Commands)
+
+		usage := readCgroupNode(filepath.Join(mount, c.CgroupPath))
+		c.MemoryCurrentMB = usage.MemoryCurrentMB
+		c.MemoryMaxMB = usage.MemoryMaxMB
+		c.CPUUsageSec = usage.CPUUsageSec
+		c.ThrottledSec = usage.ThrottledSec
+		c.CPUQuotaCPUs = usage.CPUQuotaCPUs
+		c.PidsCurrent = usage.PidsCurrent
+		c.PidsMax = usage.PidsMax
+
+		info.Containers = append(info.Containers, *c)
+	}
+
+	sort.Slice(info.Containers, func(i, j int) bool {
+		return info.Containers[i].ID < info.Containers[j].ID
+	})
+
+	return info
+}
+
+// processCgroupPaths returns the cgroup paths from /proc/<pid>/cgroup,
+// unified (v2) first, so v1-only hosts can still be matched
+func processCgroupPaths(data string) []string {
+	paths := make([]string, 0)
+	for _, line := range strings.Split(data, "\n") {
+		parts := strings.SplitN(line, ":", 3)
+		if len(parts) != 3 || parts[2] == "" || parts[2] == "/" {
+			continue
+		}
+		if parts[0] == "0" && parts[1] == "" {
+			paths = append([]string{parts[2]}, paths...)
+		} else {
+			paths = append(paths, parts[2])
+		}
+	}
+	return paths
+}
+
+type containerRef struct {
+	id         string
+	runtime    string
+	podUID     string
+	cgroupPath string
+}
+
+// findContainer returns the first container found among cgroup paths
+func findContainer(paths []string) (containerRef, bool) {
+	for _, path := range paths {
+		if ref, ok := parseContainerCgroup(path); ok {
+			return ref, true
+		}
+	}
+	return containerRef{}, false
+}
+
+// parseContainerCgroup recognizes container IDs in a cgroup path, e.g.
+//
+//	/docker/<id>
+//	/system.slice/docker-<id>.scope
+//	/machine.slice/libpod-<id>.scope
+//	/kubepods/burstable/pod<uid>/<id>
+//	/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod<uid>.slice/cri-containerd-<id>.scope
+//
+// The cgroup path returned ends at the container's own cgroup
+func parseContainerCgroup(path string) (containerRef, bool) {
+	components := strings.Split(strings.Trim(path, "/"), "/")
+
+	var ref containerRef
+	for i, component := range components {
+		name := strings.TrimSuffix(strings.TrimSuffix(component, ".scope"), ".slice")
+
+		if uid, ok := parsePodUID(name); ok {
+			ref.podUID = uid
+			continue
+		}
+
+		runtime := ""
+		if isContainerID(name) {
+			runtime = bareContainerRuntime(components[:i])
+		} else {
+			for _, p := range containerScopePrefixes {
+				if id := strings.TrimPrefix(name, p.prefix); id != name && isContainerID(id) {
+					runtime, name = p.runtime, id
+					break
+				}
+			}
+		}
+		if runtime == "" {
+			continue
+		}
+
+		ref.id = name
+		ref.runtime = runtime
+		ref.cgroupPath = "/" + strings.Join(components[:i+1], "/")
+		return ref, true
+	}
+
+	return containerRef{}, false
+}
+
+// bareContainerRuntime infers the runtime of a plain <id> cgroup from its parents
+func bareContainerRuntime(parents []string) string {
+	for _, p := range parents {
+		switch {
+		case p == "docker":
+			return "docker"
+		case p == "libpod_parent" || strings.HasPrefix(p, "libpod"):
+			return "podman"
+		case strings.HasPrefix(p, "kubepods"):
+			return "cri"
+		}
+	}
+	return "unknown"
+}
+
+// parsePodUID extracts a Kubernetes pod UID from "pod<uid>" or the systemd
+// form "kubepods-burstable-pod<uid with underscores>"
+func parsePodUID(name string) (string, bool) {
+	i := strings.LastIndex(name, "pod")
+	if i < 0 || (i > 0 && name[i-1] != '-') {
+		return "", false
+	}
+
+	uid := strings.ReplaceAll(name[i+3:], "_", "-")
+	if len(uid) != 36 {
+		return "", false
+	}
+	for j, c := range uid {
+		if j == 8 || j == 13 || j == 18 || j == 23 {
+			if c != '-' {
+				return "", false
+			}
+		} else if !isHexDigit(c) {
+			return "", false
+		}
+	}
+	return uid, true
+}
+
+// isContainerID reports whether s is a 64 character lowercase hex ID
+func isContainerID(s string) bool {
+	if len(s) != 64 {
+		return false
+	}
+	for _, c := range s {
+		if !isHexDigit(c) {
+			return false
+		}
+	}
+	return true
+}
+
+func isHexDigit(c rune) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f')
+}
+
+func containsString(items []string, value string) bool {
+	for _, item := range items {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package system
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var (
+	testContainerID = strings.Repeat("ab", 32)
+	testPodUID      = "0d2c6b1e-8f3a-4c5d-9e7f-1a2b3c4d5e6f"
+)
+
+func TestParseContainerCgroup(t *testing.T) {
+	podSystemd := strings.ReplaceAll(testPodUID, "-", "_")
+
+	tests := []struct {
+		name    string
+		path    string
+		runtime string
+		podUID  string
+		cgroup  string
+	}{
+		{"docker cgroupfs", "/docker/" + testContainerID, "docker", "", "/docker/" + testContainerID},
+		{"docker systemd", "/system.slice/docker-" + testContainerID + ".scope", "docker", "", "/system.slice/docker-" + testContainerID + ".scope"},
+		{"podman", "/machine.slice/libpod-" + testContainerID + ".scope/container", "podman", "", "/machine.slice/libpod-" + testContainerID + ".scope"},
+		{"kubernetes cgroupfs", "/kubepods/burstable/pod" + testPodUID + "/" + testContainerID, "cri", testPodUID, "/kubepods/burstable/pod" + testPodUID + "/" + testContainerID},
+		{
+			"kubernetes systemd containerd",
+			"/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod" + podSystemd + ".slice/cri-containerd-" + testContainerID + ".scope",
+			"containerd", testPodUID,
+			"/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod" + podSystemd + ".slice/cri-containerd-" + testContainerID + ".scope",
+		},
+		{"cri-o", "/kubepods.slice/kubepods-pod" + podSystemd + ".slice/crio-" + testContainerID + ".scope", "cri-o", testPodUID, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref, ok := parseContainerCgroup(tt.path)
+			if !ok {
+				t.Fatalf("Expected container in %s", tt.path)
+			}
+			if ref.id != testContainerID || ref.runtime != tt.runtime || ref.podUID != tt.podUID {
+				t.Errorf("Got %+v, want runtime %s pod %s", ref, tt.runtime, tt.podUID)
+			}
+			if tt.cgroup != "" && ref.cgroupPath != tt.cgroup {
+				t.Errorf("Got cgroup %s, want %s", ref.cgroupPath, tt.cgroup)
+			}
+		})
+	}
+
+	for _, path := range []string{
+		"/user.slice/user-1000.slice/session-2.scope",
+		"/system.slice/sshd.service",
+		"/machine.slice/libpod-conmon-" + testContainerID + ".scope",
+	} {
+		if ref, ok := parseContainerCgroup(path); ok {
+			t.Errorf("Expected no container in %s, got %+v", path, ref)
+		}
+	}
+}
+
+func TestScanContainers(t *testing.T) {
+	proc := t.TempDir()
+	mount := t.TempDir()
+	scope := "/system.slice/docker-" + testContainerID + ".scope"
+
+	writeFile(t, filepath.Join(proc, "100/cgroup"), "0::"+scope+"\n")
+	writeFile(t, filepath.Join(proc, "100/comm"), "nginx\n")
+	writeFile(t, filepath.Join(proc, "101/cgroup"), "0::"+scope+"\n")
+	writeFile(t, filepath.Join(proc, "101/comm"), "nginx\n")
+	// Hybrid host: only the v1 memory hierarchy names the container
+	writeFile(t, filepath.Join(proc, "200/cgroup"), "4:memory:/docker/"+strings.Repeat("cd", 32)+"\n0::/\n")
+	writeFile(t, filepath.Join(proc, "200/comm"), "redis-server\n")
+	writeFile(t, filepath.Join(proc, "1/cgroup"), "0::/init.scope\n")
+	writeFile(t, filepath.Join(proc, "self/cgroup"), "0::"+scope+"\n")
+
+	writeFile(t, filepath.Join(mount, scope, "memory.current"), "52428800\n")
+	writeFile(t, filepath.Join(mount, scope, "memory.max"), "104857600\n")
+	writeFile(t, filepath.Join(mount, scope, "cpu.stat"), "usage_usec 2500000\nthrottled_usec 100000\n")
+	writeFile(t, filepath.Join(mount, scope, "pids.current"), "2\n")
+
+	info := scanContainers(proc, mount)
+	if len(info.Containers) != 2 {
+		t.Fatalf("Expected 2 containers, got %+v", info.Containers)
+	}
+
+	nginx := info.Containers[0]
+	if nginx.ID != testContainerID || nginx.Runtime != "docker" || len(nginx.PIDs) != 2 || nginx.PIDs[0] != 100 {
+		t.Errorf("Unexpected nginx container %+v", nginx)
+	}
+	if len(nginx.Commands) != 1 || nginx.Commands[0] != "nginx" {
+		t.Errorf("Expected deduplicated commands, got %v", nginx.Commands)
+	}
+	if nginx.MemoryCurrentMB != 50 || nginx.MemoryMaxMB == nil || *nginx.MemoryMaxMB != 100 || nginx.CPUUsageSec != 2.5 || nginx.PidsCurrent != 2 {
+		t.Errorf("Unexpected nginx usage %+v", nginx)
+	}
+
+	if redis := info.Containers[1]; redis.Runtime != "docker" || redis.Commands[0] != "redis-server" || redis.MemoryMaxMB != nil {
+		t.Errorf("Unexpected redis container %+v", redis)
+	}
+}
@@ -11,7 +11,8 @@ import (
 )
 
 // GetCPUInfo returns CPU information
-func GetCPUInfo() (*models.CPUInfo, error) {
+// With the container scope, a cgroup CPU quota or cpuset replaces the host core count
+func GetCPUInfo(scope string) (*models.CPUInfo, error) {
 	cores := runtime.NumCPU()
 
 	// For simplicity, threads = cores * 2 (typical for modern processors)
@@ -38,13 +39,15 @@ func GetCPUInfo() (*models.CPUInfo, error) {
 	// CPU usage percent (requires more complex monitoring, set to 0 for now)
 	usagePercent := 0.0
 
-	return &models.CPUInfo{
+	info := &models.CPUInfo{
 		Cores:        cores,
 		Threads:      threads,
 		Model:        model,
 		FrequencyGHz: frequencyGHz,
 		UsagePercent: usagePercent,
-	}, nil
+	}
+	applyCPUScope(info, GetCgroupLimits(), scope)
+	return info, nil
 }
 
 // getCPUInfoLinux extracts CPU model and frequency from /proc/cpuinfo
@@ -0,0 +1,278 @@
+package system
+
+import (
+	"bufio"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+// pciIDsPaths and usbIDsPaths are where distributions install the ID databases
+var (
+	pciIDsPaths = []string{"/usr/share/hwdata/pci.ids", "/usr/share/misc/pci.ids", "/usr/share/pci.ids", "/usr/share/misc/pci.ids.gz"}
+	usbIDsPaths = []string{"/usr/share/hwdata/usb.ids", "/usr/share/misc/usb.ids", "/var/lib/usbutils/usb.ids", "/usr/share/usb.ids"}
+)
+
+// idDatabase holds names from a pci.ids or usb.ids file, keyed by lowercase hex IDs
+type idDatabase struct {
+	vendors    map[string]string // "8086"
+	devices    map[string]string // "8086:1521"
+	subsystems map[string]string // "8086:1521:8086:0001"
+	classes    map[string]string // "02"
+	subclasses map[string]string // "0200"
+}
+
+// GetDevicesInfo lists PCI and USB devices from sysfs, naming them from a
+// local pci.ids/usb.ids when one exists
+func GetDevicesInfo() (*models.DevicesInfo, error) {
+	pciPath, pciDB := loadIDDatabase(pciIDsPaths)
+	usbPath, usbDB := loadIDDatabase(usbIDsPaths)
+
+	info := &models.DevicesInfo{
+		PCIIDs: pciPath,
+		USBIDs: usbPath,
+		PCI:    readPCIDevices("/sys/bus/pci/devices", pciDB),
+		USB:    readUSBDevices("/sys/bus/usb/devices", usbDB),
+	}
+	return info, nil
+}
+
+// readPCIDevices reads each device under a /sys/bus/pci/devices directory
+func readPCIDevices(dir string, db *idDatabase) []models.PCIDevice {
+	devices := make([]models.PCIDevice, 0)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return devices
+	}
+
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		dev := models.PCIDevice{
+			Address:           entry.Name(),
+			Class:             readHexID(filepath.Join(path, "class"), 6),
+			VendorID:          readHexID(filepath.Join(path, "vendor"), 4),
+			DeviceID:          readHexID(filepath.Join(path, "device"), 4),
+			SubsystemVendorID: readHexID(filepath.Join(path, "subsystem_vendor"), 4),
+			SubsystemDeviceID: readHexID(filepath.Join(path, "subsystem_device"), 4),
+			Driver:            readDriver(path),
+			LinkSpeed:         readOptional(filepath.Join(path, "current_link_speed")),
+			LinkWidth:         readOptional(filepath.Join(path, "current_link_width")),
+			MaxLinkSpeed:      readOptional(filepath.Join(path, "max_link_speed")),
+			MaxLinkWidth:      readOptional(filepath.Join(path, "max_link_width")),
+		}
+		if node, err := readSysfsString(filepath.Join(path, "numa_node")); err == nil {
+			if n, err := strconv.Atoi(node); err == nil && n >= 0 {
+				dev.NUMANode = &n
+			}
+		}
+
+		// Devices behind a bridge sit below it in /sys/devices
+		if real, err := filepath.EvalSymlinks(path); err == nil {
+			if parent := filepath.Base(filepath.Dir(real)); isPCIAddress(parent) {
+				dev.Parent = parent
+			}
+		}
+
+		if db != nil {
+			dev.VendorName = db.vendors[dev.VendorID]
+			dev.DeviceName = db.devices[dev.VendorID+":"+dev.DeviceID]
+			dev.SubsystemName = db.subsystems[dev.VendorID+":"+dev.DeviceID+":"+dev.SubsystemVendorID+":"+dev.SubsystemDeviceID]
+			if len(dev.Class) == 6 {
+				dev.ClassName = db.subclasses[dev.Class[:4]]
+				if dev.ClassName == "" {
+					dev.ClassName = db.classes[dev.Class[:2]]
+				}
+			}
+		}
+
+		devices = append(devices, dev)
+	}
+
+	sort.Slice(devices, func(i, j int) bool { return devices[i].Address < devices[j].Address })
+	return devices
+}
+
+// readUSBDevices reads each device under /sys/bus/usb/devices, skipping interfaces
+func readUSBDevices(dir string, db *idDatabase) []models.USBDevice {
+	devices := make([]models.USBDevice, 0)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return devices
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.Contains(name, ":") {
+			continue
+		}
+		path := filepath.Join(dir, name)
+
+		dev := models.USBDevice{
+			Address:      name,
+			VendorID:     readHexID(filepath.Join(path, "idVendor"), 4),
+			ProductID:    readHexID(filepath.Join(path, "idProduct"), 4),
+			Class:        readHexID(filepath.Join(path, "bDeviceClass"), 2),
+			Manufacturer: readOptional(filepath.Join(path, "manufacturer")),
+			Product:      readOptional(filepath.Join(path, "product")),
+			Serial:       readOptional(filepath.Join(path, "serial")),
+			Driver:       readDriver(path),
+			Speed:        readOptional(filepath.Join(path, "speed")),
+		}
+		dev.Bus, _ = readSysfsUint(filepath.Join(path, "busnum"))
+		dev.Device, _ = readSysfsUint(filepath.Join(path, "devnum"))
+
+		// Root hubs are "usbN"; ports are "B-P[.P...]" under their hub
+		if i := strings.LastIndexAny(name, "-."); i > 0 {
+			dev.Parent = name[:i]
+			if name[i] == '-' {
+				dev.Parent = "usb" + name[:i]
+			}
+		}
+
+		// Class 00 defers to the interfaces; report the first one's class
+		if dev.Class == "00" {
+			if iface := readHexID(filepath.Join(path, name+":1.0", "bInterfaceClass"), 2); iface != "" {
+				dev.Class = iface
+			}
+			if dev.Driver == "" {
+				dev.Driver = readDriver(filepath.Join(path, name+":1.0"))
+			}
+		}
+
+		if db != nil {
+			dev.VendorName = db.vendors[dev.VendorID]
+			dev.ProductName = db.devices[dev.VendorID+":"+dev.ProductID]
+			dev.ClassName = db.classes[dev.Class]
+		}
+
+		devices = append(devices, dev)
+	}
+
+	sort.Slice(devices, func(i, j int) bool {
+		if devices[i].Bus != devices[j].Bus {
+			return devices[i].Bus < devices[j].Bus
+		}
+		return devices[i].Device < devices[j].Device
+	})
+	return devices
+}
+
+// readHexID reads a sysfs ID such as "0x8086" as zero-padded lowercase hex
+func readHexID(path string, width int) string {
+	value, err := readSysfsString(path)
+	if err != nil || value == "" {
+		return ""
+	}
+	value = strings.ToLower(strings.TrimPrefix(value, "0x"))
+	for len(value) < width {
+		value = "0" + value
+	}
+	return value
+}
+
+// readOptional returns a sysfs attribute, or "" when absent or unreadable
+func readOptional(path string) string {
+	value, _ := readSysfsString(path)
+	return value
+}
+
+// readDriver returns the name of the driver bound to a sysfs device
+func readDriver(path string) string {
+	target, err := os.Readlink(filepath.Join(path, "driver"))
+	if err != nil {
+		return ""
+	}
+	return filepath.Base(target)
+}
+
+// isPCIAddress reports whether name looks like "0000:00:1f.2"
+func isPCIAddress(name string) bool {
+	return len(name) == 12 && name[4] == ':' && name[7] == ':' && name[10] == '.'
+}
+
+// loadIDDatabase parses the first readable database among paths
+func loadIDDatabase(paths []string) (string, *idDatabase) {
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			continue
+		}
+		var r io.Reader = f
+		if strings.HasSuffix(path, ".gz") {
+			gz, err := gzip.NewReader(f)
+			if err != nil {
+				f.Close()
+				continue
+			}
+			r = gz
+		}
+		db := parseIDDatabase(r)
+		f.Close()
+		return path, db
+	}
+	return "", nil
+}
+
+// parseIDDatabase reads the pci.ids/usb.ids format: vendors at column 0 with
+// devices and subsystems indented by tabs, and "C xx" class sections
+func parseIDDatabase(r io.Reader) *idDatabase {
+	db := &idDatabase{
+		vendors:    make(map[string]string),
+		devices:    make(map[string]string),
+		subsystems: make(map[string]string),
+		classes:    make(map[string]string),
+		subclasses: make(map[string]string),
+	}
+
+	var section, vendor, device, class string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		depth := len(line) - len(strings.TrimLeft(line, "\t"))
+		id, name, ok := strings.Cut(strings.TrimLeft(line, "\t"), "  ")
+		if !ok {
+			continue
+		}
+		id = strings.ToLower(id)
+		name = strings.TrimSpace(name)
+
+		switch {
+		case depth == 0 && strings.HasPrefix(id, "c "):
+			section, class = "class", strings.TrimPrefix(id, "c ")
+			db.classes[class] = name
+		case depth == 0 && isHexID(id):
+			section, vendor = "vendor", id
+			db.vendors[vendor] = name
+		case depth == 0:
+			// Other usb.ids sections (HID usages, languages, ...) are not needed
+			section = ""
+		case section == "vendor" && depth == 1:
+			device = vendor + ":" + id
+			db.devices[device] = name
+		case section == "vendor" && depth == 2:
+			db.subsystems[device+":"+strings.Join(strings.Fields(id), ":")] = name
+		case section == "class" && depth == 1:
+			db.subclasses[class+id] = name
+		}
+	}
+
+	return db
+}
+
+func isHexID(s string) bool {
+	if len(s) != 4 {
+		return false
+	}
+	_, err := strconv.ParseUint(s, 16, 16)
+	return err == nil
+}
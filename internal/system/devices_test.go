@@ -0,0 +1,148 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testPCIIDs = `# pci.ids excerpt
+8086  Intel Corporation
+	1521  I350 Gigabit Network Connection
+		8086 0001  Ethernet Server Adapter I350-T4
+1000  Broadcom / LSI
+	00af  SAS3408 Fusion-MPT Tri-Mode I/O Controller Chip (IOC)
+C 01  Mass storage controller
+	07  Serial Attached SCSI controller
+C 02  Network controller
+	00  Ethernet controller
+`
+
+const testUSBIDs = `046d  Logitech, Inc.
+	c52b  Unifying Receiver
+1d6b  Linux Foundation
+	0002  2.0 root hub
+C 03  Human Interface Device
+C 09  Hub
+HID 00  Undefined
+`
+
+// linkDevice creates a sysfs device under devices/ and links it from bus/
+func linkDevice(t *testing.T, root, rel string, files map[string]string) {
+	t.Helper()
+	dir := filepath.Join(root, "devices", rel)
+	for name, content := range files {
+		writeFile(t, filepath.Join(dir, name), content)
+	}
+	bus := filepath.Join(root, "bus")
+	if err := os.MkdirAll(bus, 0o755); err != nil {
+		t.Fatalf("MkdirAll failed: %v", err)
+	}
+	if err := os.Symlink(dir, filepath.Join(bus, filepath.Base(rel))); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+}
+
+func TestParseIDDatabase(t *testing.T) {
+	db := parseIDDatabase(strings.NewReader(testPCIIDs))
+
+	if db.vendors["8086"] != "Intel Corporation" || db.devices["8086:1521"] != "I350 Gigabit Network Connection" {
+		t.Errorf("Unexpected vendor/device names: %v %v", db.vendors, db.devices)
+	}
+	if db.subsystems["8086:1521:8086:0001"] != "Ethernet Server Adapter I350-T4" {
+		t.Errorf("Unexpected subsystems: %v", db.subsystems)
+	}
+	if db.classes["01"] != "Mass storage controller" || db.subclasses["0107"] != "Serial Attached SCSI controller" {
+		t.Errorf("Unexpected classes: %v %v", db.classes, db.subclasses)
+	}
+
+	usb := parseIDDatabase(strings.NewReader(testUSBIDs))
+	if usb.devices["046d:c52b"] != "Unifying Receiver" || usb.classes["09"] != "Hub" {
+		t.Errorf("Unexpected usb names: %v %v", usb.devices, usb.classes)
+	}
+	if _, ok := usb.vendors["hid 00"]; ok {
+		t.Errorf("Expected non-vendor sections to be skipped")
+	}
+}
+
+func TestReadPCIDevices(t *testing.T) {
+	root := t.TempDir()
+	linkDevice(t, root, "pci0000:00/0000:00:01.0", map[string]string{
+		"class": "0x060400\n", "vendor": "0x8086\n", "device": "0x2030\n",
+		"subsystem_vendor": "0x8086\n", "subsystem_device": "0x0000\n", "numa_node": "-1\n",
+	})
+	linkDevice(t, root, "pci0000:00/0000:00:01.0/0000:3b:00.0", map[string]string{
+		"class": "0x020000\n", "vendor": "0x8086\n", "device": "0x1521\n",
+		"subsystem_vendor": "0x8086\n", "subsystem_device": "0x0001\n", "numa_node": "1\n",
+		"current_link_speed": "5.0 GT/s PCIe\n", "current_link_width": "4\n",
+		"max_link_speed": "5.0 GT/s PCIe\n", "max_link_width": "4\n",
+	})
+	if err := os.MkdirAll(filepath.Join(root, "drivers/igb"), 0o755); err != nil {
+		t.Fatalf("MkdirAll failed: %v", err)
+	}
+	if err := os.Symlink(filepath.Join(root, "drivers/igb"), filepath.Join(root, "devices/pci0000:00/0000:00:01.0/0000:3b:00.0/driver")); err != nil {
+		t.Fatalf("Symlink failed: %v", err)
+	}
+
+	devices := readPCIDevices(filepath.Join(root, "bus"), parseIDDatabase(strings.NewReader(testPCIIDs)))
+	if len(devices) != 2 {
+		t.Fatalf("Expected 2 devices, got %d", len(devices))
+	}
+
+	bridge, nic := devices[0], devices[1]
+	if bridge.Address != "0000:00:01.0" || bridge.Parent != "" || bridge.NUMANode != nil {
+		t.Errorf("Unexpected bridge: %+v", bridge)
+	}
+	if nic.Parent != "0000:00:01.0" || nic.Driver != "igb" || nic.NUMANode == nil || *nic.NUMANode != 1 {
+		t.Errorf("Unexpected nic topology: %+v", nic)
+	}
+	if nic.Class != "020000" || nic.ClassName != "Ethernet controller" || nic.VendorName != "Intel Corporation" {
+		t.Errorf("Unexpected nic class/vendor: %+v", nic)
+	}
+	if nic.SubsystemName != "Ethernet Server Adapter I350-T4" || nic.LinkSpeed != "5.0 GT/s PCIe" || nic.LinkWidth != "4" {
+		t.Errorf("Unexpected nic subsystem/link: %+v", nic)
+	}
+
+	// Without a database, IDs are still reported
+	devices = readPCIDevices(filepath.Join(root, "bus"), nil)
+	if devices[1].VendorID != "8086" || devices[1].DeviceID != "1521" || devices[1].DeviceName != "" {
+		t.Errorf("Unexpected unnamed nic: %+v", devices[1])
+	}
+}
+
+func TestReadUSBDevices(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "usb1/idVendor"), "1d6b\n")
+	writeFile(t, filepath.Join(dir, "usb1/idProduct"), "0002\n")
+	writeFile(t, filepath.Join(dir, "usb1/bDeviceClass"), "09\n")
+	writeFile(t, filepath.Join(dir, "usb1/busnum"), "1\n")
+	writeFile(t, filepath.Join(dir, "usb1/devnum"), "1\n")
+	writeFile(t, filepath.Join(dir, "usb1/speed"), "480\n")
+
+	writeFile(t, filepath.Join(dir, "1-1.2/idVendor"), "046d\n")
+	writeFile(t, filepath.Join(dir, "1-1.2/idProduct"), "c52b\n")
+	writeFile(t, filepath.Join(dir, "1-1.2/bDeviceClass"), "00\n")
+	writeFile(t, filepath.Join(dir, "1-1.2/busnum"), "1\n")
+	writeFile(t, filepath.Join(dir, "1-1.2/devnum"), "4\n")
+	writeFile(t, filepath.Join(dir, "1-1.2/manufacturer"), "Logitech\n")
+	writeFile(t, filepath.Join(dir, "1-1.2/product"), "USB Receiver\n")
+	writeFile(t, filepath.Join(dir, "1-1.2/1-1.2:1.0/bInterfaceClass"), "03\n")
+	writeFile(t, filepath.Join(dir, "1-1.2:1.0/bInterfaceClass"), "03\n")
+
+	devices := readUSBDevices(dir, parseIDDatabase(strings.NewReader(testUSBIDs)))
+	if len(devices) != 2 {
+		t.Fatalf("Expected interfaces to be skipped, got %+v", devices)
+	}
+
+	hub, receiver := devices[0], devices[1]
+	if hub.Address != "usb1" || hub.Parent != "" || hub.ClassName != "Hub" || hub.ProductName != "2.0 root hub" || hub.Speed != "480" {
+		t.Errorf("Unexpected root hub: %+v", hub)
+	}
+	if receiver.Parent != "1-1" || receiver.Device != 4 || receiver.Class != "03" || receiver.ClassName != "Human Interface Device" {
+		t.Errorf("Unexpected receiver: %+v", receiver)
+	}
+	if receiver.VendorName != "Logitech, Inc." || receiver.ProductName != "Unifying Receiver" || receiver.Product != "USB Receiver" {
+		t.Errorf("Unexpected receiver names: %+v", receiver)
+	}
+}
@@ -2,6 +2,7 @@ package system
 
 import (
 	"strings"
+	"time"
 
 	"github.com/example/sysinfo-cli/internal/models"
 )
@@ -11,6 +12,12 @@ import (
 func GetDiskInfo(mountPointFilter string) ([]models.DiskInfo, error) {
 	disks := getDiskInfoPlatform()
 
+	// Stamp every entry so saved JSON snapshots can be used for forecasting
+	now := time.Now().Unix()
+	for i := range disks {
+		disks[i].Timestamp = now
+	}
+
 	if mountPointFilter != "" {
 		var filtered []models.DiskInfo
 		for _, disk := range disks {
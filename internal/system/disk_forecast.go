@@ -0,0 +1,183 @@
+package system
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+// stableRateGBPerHour is the slope below which a mount is considered stable (~1 MB/h)
+const stableRateGBPerHour = 0.001
+
+// Forecast statuses
+const (
+	ForecastFilling          = "filling"
+	ForecastNotFilling       = "not filling"
+	ForecastAboveThreshold   = "above threshold"
+	ForecastInsufficientData = "insufficient data"
+)
+
+// MaxForecastSamples bounds the samples kept for a forecast, so a long
+// --watch run does not grow memory and fit cost without limit
+const MaxForecastSamples = 1440
+
+// DiskSample is one observation of disk usage at a point in time
+type DiskSample struct {
+	Time  time.Time
+	Disks []models.DiskInfo
+}
+
+// LoadDiskSnapshots reads saved `sysinfo disk --format json` outputs
+// Snapshots without timestamps fall back to the file modification time
+func LoadDiskSnapshots(paths []string) ([]DiskSample, error) {
+	samples := make([]DiskSample, 0, len(paths))
+
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var disks []models.DiskInfo
+		if err := json.Unmarshal(data, &disks); err != nil {
+			return nil, fmt.Errorf("invalid disk snapshot %s: %v", path, err)
+		}
+
+		sample := DiskSample{Disks: disks}
+		if len(disks) > 0 && disks[0].Timestamp > 0 {
+			sample.Time = time.Unix(disks[0].Timestamp, 0)
+		} else if fi, err := os.Stat(path); err == nil {
+			sample.Time = fi.ModTime()
+		}
+
+		samples = append(samples, sample)
+	}
+
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[i].Time.Before(samples[j].Time)
+	})
+
+	return samples, nil
+}
+
+// AppendDiskSample adds a sample, dropping the oldest beyond MaxForecastSamples
+func AppendDiskSample(samples []DiskSample, sample DiskSample) []DiskSample {
+	samples = append(samples, sample)
+	if excess := len(samples) - MaxForecastSamples; excess > 0 {
+		// Copy down so the backing array does not keep growing
+		samples = append(samples[:0], samples[excess:]...)
+	}
+	return samples
+}
+
+// ForecastDisks fits used space over time for each mount point with a
+// least-squares line and projects when it will cross thresholdPercent
+func ForecastDisks(samples []DiskSample, thresholdPercent float64) []models.DiskForecast {
+	type point struct {
+		hours  float64
+		usedGB float64
+	}
+
+	points := make(map[string][]point)
+	latest := make(map[string]models.DiskInfo)
+	latestTime := make(map[string]time.Time)
+	order := make([]string, 0)
+
+	var start time.Time
+	if len(samples) > 0 {
+		start = samples[0].Time
+	}
+
+	for _, sample := range samples {
+		hours := sample.Time.Sub(start).Hours()
+		for _, d := range sample.Disks {
+			if _, ok := points[d.MountPoint]; !ok {
+				order = append(order, d.MountPoint)
+			}
+			points[d.MountPoint] = append(points[d.MountPoint], point{hours: hours, usedGB: d.UsedGB})
+			latest[d.MountPoint] = d
+			latestTime[d.MountPoint] = sample.Time
+		}
+	}
+
+	forecasts := make([]models.DiskForecast, 0, len(order))
+	for _, mount := range order {
+		d := latest[mount]
+		pts := points[mount]
+
+		forecast := models.DiskForecast{
+			Filesystem:       d.Filesystem,
+			MountPoint:       mount,
+			UsagePercent:     d.UsagePercent,
+			ThresholdPercent: thresholdPercent,
+			Samples:          len(pts),
+		}
+
+		xs := make([]float64, len(pts))
+		ys := make([]float64, len(pts))
+		for i, p := range pts {
+			xs[i] = p.hours
+			ys[i] = p.usedGB
+		}
+
+		slope, ok := linearSlope(xs, ys)
+		switch {
+		case d.UsagePercent >= thresholdPercent:
+			forecast.Status = ForecastAboveThreshold
+			zero := 0.0
+			forecast.HoursToThreshold = &zero
+			forecast.FillRateGBPerHour = slope
+		case !ok:
+			forecast.Status = ForecastInsufficientData
+		case slope < stableRateGBPerHour:
+			forecast.Status = ForecastNotFilling
+			forecast.FillRateGBPerHour = slope
+		default:
+			forecast.Status = ForecastFilling
+			forecast.FillRateGBPerHour = slope
+
+			targetGB := d.SizeGB * thresholdPercent / 100
+			hours := (targetGB - d.UsedGB) / slope
+			forecast.HoursToThreshold = &hours
+			fullAt := latestTime[mount].Add(time.Duration(hours * float64(time.Hour)))
+			forecast.EstimatedFullAt = fullAt.UTC().Format(time.RFC3339)
+		}
+
+		forecasts = append(forecasts, forecast)
+	}
+
+	return forecasts
+}
+
+// linearSlope returns the least-squares slope of ys over xs
+// ok is false when there are fewer than two distinct x values
+func linearSlope(xs, ys []float64) (float64, bool) {
+	n := float64(len(xs))
+	if len(xs) < 2 {
+		return 0, false
+	}
+
+	var sumX, sumY float64
+	for i := range xs {
+		sumX += xs[i]
+		sumY += ys[i]
+	}
+	meanX := sumX / n
+	meanY := sumY / n
+
+	var cov, variance float64
+	for i := range xs {
+		dx := xs[i] - meanX
+		cov += dx * (ys[i] - meanY)
+		variance += dx * dx
+	}
+
+	if variance == 0 {
+		return 0, false
+	}
+	return cov / variance, true
+}
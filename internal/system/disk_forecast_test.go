@@ -0,0 +1,155 @@
+package system
+
+import (
+	"encoding/json"
+	"math"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+func diskSample(start time.Time, hours float64, mount string, sizeGB, usedGB float64) DiskSample {
+	return DiskSample{
+		Time: start.Add(time.Duration(hours * float64(time.Hour))),
+		Disks: []models.DiskInfo{{
+			Filesystem:   "/dev/sda1",
+			MountPoint:   mount,
+			SizeGB:       sizeGB,
+			UsedGB:       usedGB,
+			UsagePercent: usedGB / sizeGB * 100,
+		}},
+	}
+}
+
+func TestForecastDisksFilling(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	samples := []DiskSample{
+		diskSample(start, 0, "/", 100, 50),
+		diskSample(start, 1, "/", 100, 52),
+		diskSample(start, 2, "/", 100, 54),
+	}
+
+	forecasts := ForecastDisks(samples, 100)
+	if len(forecasts) != 1 {
+		t.Fatalf("Expected 1 forecast, got %d", len(forecasts))
+	}
+
+	f := forecasts[0]
+	if f.Status != ForecastFilling {
+		t.Fatalf("Expected status %q, got %q", ForecastFilling, f.Status)
+	}
+	if math.Abs(f.FillRateGBPerHour-2.0) > 1e-9 {
+		t.Errorf("Expected fill rate 2 GB/h, got %.4f", f.FillRateGBPerHour)
+	}
+	if f.HoursToThreshold == nil || math.Abs(*f.HoursToThreshold-23.0) > 1e-9 {
+		t.Errorf("Expected 23 hours to full, got %v", f.HoursToThreshold)
+	}
+	if f.EstimatedFullAt != "2026-01-02T01:00:00Z" {
+		t.Errorf("Unexpected estimated full time %s", f.EstimatedFullAt)
+	}
+}
+
+func TestForecastDisksThreshold(t *testing.T) {
+	start := time.Now()
+	samples := []DiskSample{
+		diskSample(start, 0, "/data", 100, 80),
+		diskSample(start, 10, "/data", 100, 85),
+	}
+
+	f := ForecastDisks(samples, 90)[0]
+	if f.HoursToThreshold == nil || math.Abs(*f.HoursToThreshold-10.0) > 1e-9 {
+		t.Errorf("Expected 10 hours to 90%%, got %v", f.HoursToThreshold)
+	}
+
+	f = ForecastDisks(samples, 80)[0]
+	if f.Status != ForecastAboveThreshold {
+		t.Errorf("Expected status %q, got %q", ForecastAboveThreshold, f.Status)
+	}
+}
+
+func TestForecastDisksNotFilling(t *testing.T) {
+	start := time.Now()
+	tests := []struct {
+		name   string
+		usedGB []float64
+	}{
+		{"stable", []float64{40, 40, 40}},
+		{"shrinking", []float64{40, 35, 30}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var samples []DiskSample
+			for i, used := range tt.usedGB {
+				samples = append(samples, diskSample(start, float64(i), "/", 100, used))
+			}
+
+			f := ForecastDisks(samples, 100)[0]
+			if f.Status != ForecastNotFilling {
+				t.Errorf("Expected status %q, got %q", ForecastNotFilling, f.Status)
+			}
+			if f.HoursToThreshold != nil {
+				t.Errorf("Expected no time to threshold, got %.2f", *f.HoursToThreshold)
+			}
+		})
+	}
+}
+
+func TestForecastDisksInsufficientData(t *testing.T) {
+	samples := []DiskSample{diskSample(time.Now(), 0, "/", 100, 50)}
+
+	f := ForecastDisks(samples, 100)[0]
+	if f.Status != ForecastInsufficientData {
+		t.Errorf("Expected status %q, got %q", ForecastInsufficientData, f.Status)
+	}
+}
+
+func TestAppendDiskSampleBounded(t *testing.T) {
+	start := time.Now()
+	var samples []DiskSample
+	for i := 0; i < MaxForecastSamples+5; i++ {
+		samples = AppendDiskSample(samples, diskSample(start, float64(i), "/", 100, 50))
+	}
+
+	if len(samples) != MaxForecastSamples {
+		t.Fatalf("Expected %d samples, got %d", MaxForecastSamples, len(samples))
+	}
+	if !samples[0].Time.Equal(start.Add(5 * time.Hour)) {
+		t.Errorf("Expected the oldest samples to be dropped, first is %v", samples[0].Time)
+	}
+}
+
+func TestLoadDiskSnapshots(t *testing.T) {
+	dir := t.TempDir()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// Write out of order to verify samples are sorted by time
+	for i, used := range []float64{60, 50} {
+		disks := []models.DiskInfo{{
+			MountPoint: "/",
+			SizeGB:     100,
+			UsedGB:     used,
+			Timestamp:  start.Add(time.Duration(1-i) * time.Hour).Unix(),
+		}}
+		data, err := json.Marshal(disks)
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		writeFile(t, filepath.Join(dir, []string{"b.json", "a.json"}[i]), string(data))
+	}
+
+	samples, err := LoadDiskSnapshots([]string{filepath.Join(dir, "b.json"), filepath.Join(dir, "a.json")})
+	if err != nil {
+		t.Fatalf("LoadDiskSnapshots failed: %v", err)
+	}
+	if len(samples) != 2 || samples[0].Disks[0].UsedGB != 50 {
+		t.Errorf("Expected snapshots sorted oldest first, got %+v", samples)
+	}
+
+	writeFile(t, filepath.Join(dir, "bad.json"), "not json")
+	if _, err := LoadDiskSnapshots([]string{filepath.Join(dir, "bad.json")}); err == nil {
+		t.Errorf("Expected error for invalid snapshot")
+	}
+}
@@ -0,0 +1,171 @@
+package system
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+	"time"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+// probeSizeBytes is the size of the temp file written on each iteration
+const probeSizeBytes = 4096
+
+// Probe operations, in the order they are performed
+var probeOperations = []string{"write", "fsync", "read", "delete"}
+
+// ProbeDisk measures write, fsync, read-back and delete latency on a mount
+// by cycling a small temp file. Reads may be served from the page cache.
+func ProbeDisk(mountPoint string, iterations int) (*models.DiskProbeResult, error) {
+	fi, err := os.Stat(mountPoint)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", mountPoint)
+	}
+
+	if isReadOnlyMount(mountPoint) {
+		return nil, fmt.Errorf("refusing to probe read-only mount %s", mountPoint)
+	}
+
+	// Catch interrupts so the temp file is always removed
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupt)
+
+	samples := make(map[string][]time.Duration)
+	payload := make([]byte, probeSizeBytes)
+	for i := range payload {
+		payload[i] = byte(i)
+	}
+
+	for i := 0; i < iterations; i++ {
+		select {
+		case <-interrupt:
+			return nil, errors.New("probe interrupted")
+		default:
+		}
+
+		timings, err := probeOnce(mountPoint, payload)
+		if err != nil {
+			return nil, err
+		}
+		for op, d := range timings {
+			samples[op] = append(samples[op], d)
+		}
+	}
+
+	result := &models.DiskProbeResult{
+		MountPoint: mountPoint,
+		Iterations: iterations,
+		SizeBytes:  probeSizeBytes,
+		Operations: make([]models.LatencyStats, 0, len(probeOperations)),
+	}
+	for _, op := range probeOperations {
+		stats := latencyStats(samples[op])
+		stats.Operation = op
+		result.Operations = append(result.Operations, stats)
+	}
+
+	return result, nil
+}
+
+// probeOnce runs a single write/fsync/read/delete cycle
+func probeOnce(dir string, payload []byte) (map[string]time.Duration, error) {
+	file, err := os.CreateTemp(dir, ".sysinfo-probe-*")
+	if err != nil {
+		return nil, err
+	}
+	name := file.Name()
+	removed := false
+	defer func() {
+		if !removed {
+			file.Close()
+			os.Remove(name)
+		}
+	}()
+
+	timings := make(map[string]time.Duration, len(probeOperations))
+
+	start := time.Now()
+	if _, err := file.Write(payload); err != nil {
+		return nil, err
+	}
+	timings["write"] = time.Since(start)
+
+	start = time.Now()
+	if err := file.Sync(); err != nil {
+		return nil, err
+	}
+	timings["fsync"] = time.Since(start)
+
+	if err := file.Close(); err != nil {
+		return nil, err
+	}
+
+	start = time.Now()
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	timings["read"] = time.Since(start)
+	if len(data) != len(payload) {
+		return nil, fmt.Errorf("probe read back %d bytes, expected %d", len(data), len(payload))
+	}
+
+	start = time.Now()
+	if err := os.Remove(name); err != nil {
+		return nil, err
+	}
+	timings["delete"] = time.Since(start)
+	removed = true
+
+	return timings, nil
+}
+
+// latencyStats computes min/avg/percentiles/max in milliseconds
+func latencyStats(samples []time.Duration) models.LatencyStats {
+	if len(samples) == 0 {
+		return models.LatencyStats{}
+	}
+
+	sorted := make([]time.Duration, len(samples))
+	copy(sorted, samples)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var total time.Duration
+	for _, d := range sorted {
+		total += d
+	}
+
+	return models.LatencyStats{
+		MinMs: durationMs(sorted[0]),
+		AvgMs: durationMs(total / time.Duration(len(sorted))),
+		P50Ms: durationMs(percentile(sorted, 50)),
+		P95Ms: durationMs(percentile(sorted, 95)),
+		P99Ms: durationMs(percentile(sorted, 99)),
+		MaxMs: durationMs(sorted[len(sorted)-1]),
+	}
+}
+
+// percentile returns the nearest-rank percentile of an ascending slice
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(sorted) {
+		rank = len(sorted)
+	}
+	return sorted[rank-1]
+}
+
+func durationMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,62 @@
+package system
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestProbeDisk(t *testing.T) {
+	dir := t.TempDir()
+
+	result, err := ProbeDisk(dir, 5)
+	if err != nil {
+		t.Fatalf("ProbeDisk failed: %v", err)
+	}
+
+	if result.Iterations != 5 || len(result.Operations) != 4 {
+		t.Fatalf("Unexpected result: %+v", result)
+	}
+
+	for _, op := range result.Operations {
+		if op.MinMs > op.P50Ms || op.P50Ms > op.P95Ms || op.P95Ms > op.P99Ms || op.P99Ms > op.MaxMs {
+			t.Errorf("%s: percentiles out of order: %+v", op.Operation, op)
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir failed: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("Expected probe files to be cleaned up, found %d entries", len(entries))
+	}
+}
+
+func TestProbeDiskMissingMount(t *testing.T) {
+	if _, err := ProbeDisk("/nonexistent/sysinfo-probe", 1); err == nil {
+		t.Errorf("Expected error for missing mount")
+	}
+}
+
+func TestLatencyStats(t *testing.T) {
+	samples := make([]time.Duration, 0, 100)
+	for i := 100; i >= 1; i-- {
+		samples = append(samples, time.Duration(i)*time.Millisecond)
+	}
+
+	stats := latencyStats(samples)
+	if stats.MinMs != 1 || stats.MaxMs != 100 {
+		t.Errorf("Expected min 1 and max 100, got %.2f and %.2f", stats.MinMs, stats.MaxMs)
+	}
+	if stats.P50Ms != 50 || stats.P95Ms != 95 || stats.P99Ms != 99 {
+		t.Errorf("Unexpected percentiles: %+v", stats)
+	}
+	if stats.AvgMs != 50.5 {
+		t.Errorf("Expected avg 50.5, got %.2f", stats.AvgMs)
+	}
+
+	if empty := latencyStats(nil); empty.MaxMs != 0 {
+		t.Errorf("Expected zero stats for no samples, got %+v", empty)
+	}
+}
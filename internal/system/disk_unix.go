@@ -5,6 +5,7 @@ package system
 
 import (
 	"bufio"
+	"errors"
 	"os"
 	"runtime"
 	"strings"
@@ -107,3 +108,13 @@ func getDiskInfoDarwin() []models.DiskInfo {
 
 	return disks
 }
+
+// writeAccess checks write permission on path; tests swap it to simulate EROFS
+var writeAccess = func(path string) error {
+	return unix.Access(path, unix.W_OK)
+}
+
+// isReadOnlyMount reports whether the filesystem containing path is read-only
+func isReadOnlyMount(path string) bool {
+	return errors.Is(writeAccess(path), unix.EROFS)
+}
@@ -0,0 +1,49 @@
+//go:build linux || darwin
+// +build linux darwin
+
+package system
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestProbeDiskRefusesReadOnlyMount(t *testing.T) {
+	dir := t.TempDir()
+
+	orig := writeAccess
+	writeAccess = func(string) error { return unix.EROFS }
+	defer func() { writeAccess = orig }()
+
+	_, err := ProbeDisk(dir, 1)
+	if err == nil || !strings.Contains(err.Error(), "read-only") {
+		t.Fatalf("Expected read-only refusal, got %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir failed: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("Expected nothing written to a read-only mount, found %d entries", len(entries))
+	}
+}
+
+func TestIsReadOnlyMountOtherErrors(t *testing.T) {
+	orig := writeAccess
+	defer func() { writeAccess = orig }()
+
+	// Permission problems are not a read-only filesystem
+	writeAccess = func(string) error { return unix.EACCES }
+	if isReadOnlyMount("/data") {
+		t.Errorf("Expected EACCES not to count as read-only")
+	}
+
+	writeAccess = func(string) error { return nil }
+	if isReadOnlyMount("/data") {
+		t.Errorf("Expected a writable mount not to be read-only")
+	}
+}
@@ -58,3 +58,8 @@ func getDiskInfoWindows() []models.DiskInfo {
 
 	return disks
 }
+
+// isReadOnlyMount is not detected on Windows; write failures surface instead
+func isReadOnlyMount(path string) bool {
+	return false
+}
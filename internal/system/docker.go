@@ -0,0 +1,250 @@
+package system
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+// DefaultDockerSocket is where the Docker Engine API listens by default
+const DefaultDockerSocket = "/var/run/docker.sock"
+
+// dockerTimeout bounds each API call; stats wait about a second for a second sample
+const dockerTimeout = 10 * time.Second
+
+// dockerStatsWorkers caps concurrent stats requests against the daemon
+const dockerStatsWorkers = 8
+
+// dockerClient talks to a Docker-compatible Engine API over a unix socket
+type dockerClient struct {
+	http *http.Client
+}
+
+func newDockerClient(socket string) *dockerClient {
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", socket)
+		},
+	}
+	return &dockerClient{
+		http: &http.Client{Transport: transport, Timeout: dockerTimeout},
+	}
+}
+
+// get decodes the JSON response of an unversioned API path into v
+// Unversioned paths let the daemon pick its own API version, which also
+// keeps podman's compatibility endpoint working
+func (c *dockerClient) get(path string, v interface{}) error {
+	resp, err := c.http.Get("http://docker" + path)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		json.NewDecoder(resp.Body).Decode(&apiErr)
+		if apiErr.Message != "" {
+			return fmt.Errorf("%s: %s", resp.Status, apiErr.Message)
+		}
+		return errors.New(resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// GetDockerInfo lists running containers through the Engine API on socket and
+// fetches one stats sample for each. An absent or inaccessible socket is
+// reported in the result rather than as an error.
+func GetDockerInfo(socket string, names []string) (*models.DockerInfo, error) {
+	info := &models.DockerInfo{
+		Socket:     socket,
+		Containers: make([]models.DockerContainer, 0),
+	}
+
+	if _, err := os.Stat(socket); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			info.Message = fmt.Sprintf("Docker socket %s not found; is the daemon running?", socket)
+		} else {
+			info.Message = fmt.Sprintf("cannot access Docker socket %s: %v", socket, err)
+		}
+		return info, nil
+	}
+
+	client := newDockerClient(socket)
+
+	var list []dockerContainerSummary
+	if err := client.get("/containers/json", &list); err != nil {
+		info.Message = fmt.Sprintf("Docker API request failed: %v", err)
+		return info, nil
+	}
+	info.Available = true
+
+	for _, summary := range list {
+		c := summary.toModel()
+		if matchesAnyName(c.Name, names) {
+			info.Containers = append(info.Containers, c)
+		}
+	}
+
+	// Each stats call blocks for about a second while the daemon takes a
+	// second sample, so fetch them concurrently, a few at a time
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, dockerStatsWorkers)
+	for i := range info.Containers {
+		wg.Add(1)
+		go func(c *models.DockerContainer) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			var stats dockerStats
+			path := "/containers/" + url.PathEscape(c.ID) + "/stats?stream=false"
+			if err := client.get(path, &stats); err != nil {
+				c.StatsError = err.Error()
+				return
+			}
+			stats.apply(c)
+		}(&info.Containers[i])
+	}
+	wg.Wait()
+
+	sort.Slice(info.Containers, func(i, j int) bool {
+		return info.Containers[i].Name < info.Containers[j].Name
+	})
+
+	return info, nil
+}
+
+// dockerContainerSummary is the subset of GET /containers/json used here
+type dockerContainerSummary struct {
+	ID     string            `json:"Id"`
+	Names  []string          `json:"Names"`
+	Image  string            `json:"Image"`
+	State  string            `json:"State"`
+	Status string            `json:"Status"`
+	Labels map[string]string `json:"Labels"`
+	Ports  []struct {
+		IP          string `json:"IP"`
+		PrivatePort int    `json:"PrivatePort"`
+		PublicPort  int    `json:"PublicPort"`
+		Type        string `json:"Type"`
+	} `json:"Ports"`
+}
+
+func (s dockerContainerSummary) toModel() models.DockerContainer {
+	c := models.DockerContainer{
+		ID:     s.ID,
+		Image:  s.Image,
+		State:  s.State,
+		Status: s.Status,
+		Ports:  make([]string, 0, len(s.Ports)),
+		Labels: s.Labels,
+	}
+	if len(s.Names) > 0 {
+		c.Name = strings.TrimPrefix(s.Names[0], "/")
+	}
+	if c.Labels == nil {
+		c.Labels = make(map[string]string)
+	}
+
+	// Rendered like `docker ps`: 0.0.0.0:8080->80/tcp, or 80/tcp when unpublished
+	for _, p := range s.Ports {
+		if p.PublicPort > 0 {
+			c.Ports = append(c.Ports, fmt.Sprintf("%s:%d->%d/%s", p.IP, p.PublicPort, p.PrivatePort, p.Type))
+		} else {
+			c.Ports = append(c.Ports, fmt.Sprintf("%d/%s", p.PrivatePort, p.Type))
+		}
+	}
+	sort.Strings(c.Ports)
+
+	return c
+}
+
+// dockerStats is the subset of GET /containers/{id}/stats used here
+type dockerStats struct {
+	CPUStats    dockerCPUStats `json:"cpu_stats"`
+	PreCPUStats dockerCPUStats `json:"precpu_stats"`
+	MemoryStats struct {
+		Usage uint64            `json:"usage"`
+		Limit uint64            `json:"limit"`
+		Stats map[string]uint64 `json:"stats"`
+	} `json:"memory_stats"`
+	Networks map[string]struct {
+		RxBytes uint64 `json:"rx_bytes"`
+		TxBytes uint64 `json:"tx_bytes"`
+	} `json:"networks"`
+	BlkioStats struct {
+		IOServiceBytesRecursive []struct {
+			Op    string `json:"op"`
+			Value uint64 `json:"value"`
+		} `json:"io_service_bytes_recursive"`
+	} `json:"blkio_stats"`
+}
+
+type dockerCPUStats struct {
+	CPUUsage struct {
+		TotalUsage  uint64   `json:"total_usage"`
+		PercpuUsage []uint64 `json:"percpu_usage"`
+	} `json:"cpu_usage"`
+	SystemCPUUsage uint64 `json:"system_cpu_usage"`
+	OnlineCPUs     uint32 `json:"online_cpus"`
+}
+
+// apply computes the same figures as `docker stats`
+func (s dockerStats) apply(c *models.DockerContainer) {
+	cpuDelta := float64(s.CPUStats.CPUUsage.TotalUsage) - float64(s.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(s.CPUStats.SystemCPUUsage) - float64(s.PreCPUStats.SystemCPUUsage)
+	onlineCPUs := float64(s.CPUStats.OnlineCPUs)
+	if onlineCPUs == 0 {
+		onlineCPUs = float64(len(s.CPUStats.CPUUsage.PercpuUsage))
+	}
+	if s.PreCPUStats.SystemCPUUsage > 0 && cpuDelta >= 0 && systemDelta > 0 {
+		percent := cpuDelta / systemDelta * onlineCPUs * 100
+		c.CPUPercent = &percent
+	}
+
+	// Page cache that can be reclaimed is not counted, matching the docker CLI
+	usage := s.MemoryStats.Usage
+	cache := s.MemoryStats.Stats["inactive_file"]
+	if v, ok := s.MemoryStats.Stats["total_inactive_file"]; ok {
+		cache = v
+	}
+	if cache < usage {
+		usage -= cache
+	}
+	c.MemoryUsageMB = bytesToMB(usage)
+	c.MemoryLimitMB = bytesToMB(s.MemoryStats.Limit)
+	if s.MemoryStats.Limit > 0 {
+		c.MemoryPercent = float64(usage) / float64(s.MemoryStats.Limit) * 100
+	}
+
+	for _, n := range s.Networks {
+		c.NetRxMB += bytesToMB(n.RxBytes)
+		c.NetTxMB += bytesToMB(n.TxBytes)
+	}
+
+	for _, entry := range s.BlkioStats.IOServiceBytesRecursive {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			c.BlockReadMB += bytesToMB(entry.Value)
+		case "write":
+			c.BlockWriteMB += bytesToMB(entry.Value)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package system
+
+import (
+	"encoding/json"
+	"math"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// startFakeDocker serves handler on a unix socket in a temp directory
+func startFakeDocker(t *testing.T, handler http.Handler) string {
+	t.Helper()
+
+	socket := filepath.Join(t.TempDir(), "docker.sock")
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Skipf("unix sockets not supported: %v", err)
+	}
+
+	server := httptest.NewUnstartedServer(handler)
+	server.Listener = listener
+	server.Start()
+	t.Cleanup(server.Close)
+
+	return socket
+}
+
+func TestGetDockerInfo(t *testing.T) {
+	id := strings.Repeat("a", 64)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/containers/json", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]map[string]interface{}{
+			{
+				"Id":     id,
+				"Names":  []string{"/web"},
+				"Image":  "nginx:1.27",
+				"State":  "running",
+				"Status": "Up 3 hours",
+				"Labels": map[string]string{"com.docker.compose.service": "web"},
+				"Ports": []map[string]interface{}{
+					{"IP": "0.0.0.0", "PrivatePort": 80, "PublicPort": 8080, "Type": "tcp"},
+					{"PrivatePort": 443, "Type": "tcp"},
+				},
+			},
+			{"Id": strings.Repeat("b", 64), "Names": []string{"/broken"}, "Image": "busybox", "State": "running"},
+		})
+	})
+	mux.HandleFunc("/containers/"+id+"/stats", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("stream") != "false" {
+			t.Errorf("Expected a single stats sample, got query %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{
+			"cpu_stats": {"cpu_usage": {"total_usage": 300000000}, "system_cpu_usage": 2000000000, "online_cpus": 4},
+			"precpu_stats": {"cpu_usage": {"total_usage": 100000000}, "system_cpu_usage": 1000000000, "online_cpus": 4},
+			"memory_stats": {"usage": 157286400, "limit": 1073741824, "stats": {"inactive_file": 52428800}},
+			"networks": {"eth0": {"rx_bytes": 1048576, "tx_bytes": 2097152}, "eth1": {"rx_bytes": 1048576, "tx_bytes": 0}},
+			"blkio_stats": {"io_service_bytes_recursive": [{"op": "read", "value": 5242880}, {"op": "write", "value": 1048576}]}
+		}`))
+	})
+	mux.HandleFunc("/containers/"+strings.Repeat("b", 64)+"/stats", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "No such container"}`))
+	})
+
+	info, err := GetDockerInfo(startFakeDocker(t, mux), nil)
+	if err != nil {
+		t.Fatalf("GetDockerInfo failed: %v", err)
+	}
+	if !info.Available || len(info.Containers) != 2 {
+		t.Fatalf("Expected 2 containers, got %+v", info)
+	}
+
+	broken := info.Containers[0]
+	if broken.Name != "broken" || !strings.Contains(broken.StatsError, "No such container") {
+		t.Errorf("Expected stats error for broken, got %+v", broken)
+	}
+
+	web := info.Containers[1]
+	if web.Name != "web" || web.Image != "nginx:1.27" || web.Status != "Up 3 hours" || web.Labels["com.docker.compose.service"] != "web" {
+		t.Errorf("Unexpected container %+v", web)
+	}
+	if len(web.Ports) != 2 || web.Ports[0] != "0.0.0.0:8080->80/tcp" || web.Ports[1] != "443/tcp" {
+		t.Errorf("Unexpected ports %v", web.Ports)
+	}
+	if web.CPUPercent == nil || math.Abs(*web.CPUPercent-80) > 1e-9 {
+		t.Errorf("Expected 80%% CPU, got %v", web.CPUPercent)
+	}
+	if web.MemoryUsageMB != 100 || web.MemoryLimitMB != 1024 || math.Abs(web.MemoryPercent-100.0/1024*100) > 1e-9 {
+		t.Errorf("Unexpected memory %+v", web)
+	}
+	if web.NetRxMB != 2 || web.NetTxMB != 2 || web.BlockReadMB != 5 || web.BlockWriteMB != 1 {
+		t.Errorf("Unexpected I/O %+v", web)
+	}
+
+	info, _ = GetDockerInfo(startFakeDocker(t, mux), []string{"we"})
+	if len(info.Containers) != 1 || info.Containers[0].Name != "web" {
+		t.Errorf("Expected name filter to keep only web, got %+v", info.Containers)
+	}
+}
+
+func TestGetDockerInfoStatsConcurrencyCapped(t *testing.T) {
+	const containers = dockerStatsWorkers * 4
+	var inFlight, peak int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/containers/json", func(w http.ResponseWriter, r *http.Request) {
+		list := make([]map[string]interface{}, containers)
+		for i := range list {
+			list[i] = map[string]interface{}{"Id": strings.Repeat(string(rune('a'+i%26)), 63) + string(rune('0'+i/26)), "Names": []string{"/c"}}
+		}
+		json.NewEncoder(w).Encode(list)
+	})
+	mux.HandleFunc("/containers/", func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.Write([]byte(`{}`))
+	})
+
+	info, err := GetDockerInfo(startFakeDocker(t, mux), nil)
+	if err != nil {
+		t.Fatalf("GetDockerInfo failed: %v", err)
+	}
+	if len(info.Containers) != containers {
+		t.Fatalf("Expected %d containers, got %d", containers, len(info.Containers))
+	}
+	if peak > dockerStatsWorkers {
+		t.Errorf("Expected at most %d concurrent stats calls, saw %d", dockerStatsWorkers, peak)
+	}
+}
+
+func TestGetDockerInfoUnavailable(t *testing.T) {
+	info, err := GetDockerInfo(filepath.Join(t.TempDir(), "missing.sock"), nil)
+	if err != nil {
+		t.Fatalf("Expected graceful result, got error %v", err)
+	}
+	if info.Available || !strings.Contains(info.Message, "not found") {
+		t.Errorf("Expected unavailable result, got %+v", info)
+	}
+
+	// A socket that answers with errors is also reported, not fatal
+	socket := startFakeDocker(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "boom", http.StatusInternalServerError)
+	}))
+	info, err = GetDockerInfo(socket, nil)
+	if err != nil || info.Available || !strings.Contains(info.Message, "500") {
+		t.Errorf("Expected API failure message, got %+v, %v", info, err)
+	}
+}
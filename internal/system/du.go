@@ -0,0 +1,307 @@
+package system
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+// maxDUWarnings caps how many individual error messages are kept in a scan
+const maxDUWarnings = 20
+
+// DUOptions controls a directory usage scan
+type DUOptions struct {
+	Path        string
+	Limit       int
+	Workers     int
+	CrossMounts bool
+}
+
+// fileStat holds the platform-specific details needed by the scanner
+// Implementations live in du_unix.go and du_windows.go
+type fileStat struct {
+	dev       uint64
+	ino       uint64
+	nlink     uint64
+	allocated uint64
+	uid       uint32
+}
+
+// fileID identifies a file across hardlinks
+type fileID struct {
+	dev uint64
+	ino uint64
+}
+
+// duDir accumulates the sizes of entries directly inside one directory
+type duDir struct {
+	parent    string
+	depth     int
+	apparent  uint64
+	allocated uint64
+}
+
+// duScanner walks a tree concurrently and accumulates usage per directory
+type duScanner struct {
+	opts    DUOptions
+	rootDev uint64
+
+	mu       sync.Mutex
+	dirs     map[string]*duDir
+	files    []models.DUEntry
+	seen     map[fileID]bool
+	nfiles   int64
+	errors   int
+	warnings []string
+}
+
+// GetDUInfo scans opts.Path and returns the largest directories and files
+// Permission errors do not abort the scan; the result is marked partial
+func GetDUInfo(opts DUOptions) (*models.DUInfo, error) {
+	root, err := filepath.Abs(opts.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Follow a symlinked root; entries below it are still not followed
+	fi, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", root)
+	}
+
+	if opts.Workers < 1 {
+		opts.Workers = 1
+	}
+	if opts.Limit < 1 {
+		opts.Limit = 10
+	}
+
+	s := &duScanner{
+		opts:    opts,
+		rootDev: statFile(fi).dev,
+		dirs:    make(map[string]*duDir),
+		files:   make([]models.DUEntry, 0),
+		seen:    make(map[fileID]bool),
+	}
+	s.dirs[root] = &duDir{depth: 0}
+	s.addDirSelf(root, fi)
+
+	s.run(root)
+
+	return s.result(root), nil
+}
+
+// run processes directories with a fixed pool of workers. The dispatcher
+// owns the queue so workers can discover new directories without blocking.
+func (s *duScanner) run(root string) {
+	jobs := make(chan string)
+	found := make(chan []string)
+
+	var wg sync.WaitGroup
+	for i := 0; i < s.opts.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for dir := range jobs {
+				found <- s.scanDir(dir)
+			}
+		}()
+	}
+
+	queue := []string{root}
+	pending := 0
+	for len(queue) > 0 || pending > 0 {
+		var send chan string
+		var next string
+		if len(queue) > 0 {
+			send = jobs
+			next = queue[len(queue)-1]
+		}
+
+		select {
+		case send <- next:
+			queue = queue[:len(queue)-1]
+			pending++
+		case dirs := <-found:
+			pending--
+			queue = append(queue, dirs...)
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+}
+
+// scanDir accounts for the entries of dir and returns its subdirectories
+func (s *duScanner) scanDir(dir string) []string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		s.warn(err)
+		// ReadDir may still return the entries read before the error
+		if len(entries) == 0 {
+			return nil
+		}
+	}
+
+	s.mu.Lock()
+	depth := s.dirs[dir].depth
+	s.mu.Unlock()
+
+	var subdirs []string
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+
+		fi, err := os.Lstat(path)
+		if err != nil {
+			s.warn(err)
+			continue
+		}
+
+		if fi.IsDir() {
+			if !s.opts.CrossMounts && statFile(fi).dev != s.rootDev {
+				continue
+			}
+			s.mu.Lock()
+			s.dirs[path] = &duDir{parent: dir, depth: depth + 1}
+			s.mu.Unlock()
+			s.addDirSelf(path, fi)
+			subdirs = append(subdirs, path)
+			continue
+		}
+
+		if !fi.Mode().IsRegular() {
+			continue
+		}
+
+		s.addFile(dir, path, fi)
+	}
+
+	return subdirs
+}
+
+// addDirSelf counts the space used by the directory inode itself
+func (s *duScanner) addDirSelf(path string, fi os.FileInfo) {
+	st := statFile(fi)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d := s.dirs[path]
+	d.apparent += uint64(fi.Size())
+	d.allocated += st.allocated
+}
+
+// addFile counts a regular file once, even if it has several hardlinks
+func (s *duScanner) addFile(dir, path string, fi os.FileInfo) {
+	st := statFile(fi)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if st.nlink > 1 {
+		id := fileID{dev: st.dev, ino: st.ino}
+		if s.seen[id] {
+			return
+		}
+		s.seen[id] = true
+	}
+
+	d := s.dirs[dir]
+	d.apparent += uint64(fi.Size())
+	d.allocated += st.allocated
+	s.nfiles++
+
+	s.files = append(s.files, models.DUEntry{
+		Path:        path,
+		Type:        "file",
+		ApparentMB:  bytesToMB(uint64(fi.Size())),
+		AllocatedMB: bytesToMB(st.allocated),
+	})
+
+	// Keep memory bounded on large trees by trimming to the top entries
+	if len(s.files) > 4*s.opts.Limit+1024 {
+		s.files = topDUEntries(s.files, s.opts.Limit)
+	}
+}
+
+func (s *duScanner) warn(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.errors++
+	if len(s.warnings) < maxDUWarnings {
+		s.warnings = append(s.warnings, err.Error())
+	}
+}
+
+// result rolls per-directory sizes up into recursive totals
+func (s *duScanner) result(root string) *models.DUInfo {
+	paths := make([]string, 0, len(s.dirs))
+	for path := range s.dirs {
+		paths = append(paths, path)
+	}
+	// Deepest first so each directory is complete before it is added to its parent
+	sort.Slice(paths, func(i, j int) bool {
+		return s.dirs[paths[i]].depth > s.dirs[paths[j]].depth
+	})
+
+	dirs := make([]models.DUEntry, 0, len(paths))
+	for _, path := range paths {
+		d := s.dirs[path]
+		if path != root {
+			parent := s.dirs[d.parent]
+			parent.apparent += d.apparent
+			parent.allocated += d.allocated
+		}
+		dirs = append(dirs, models.DUEntry{
+			Path:        path,
+			Type:        "dir",
+			ApparentMB:  bytesToMB(d.apparent),
+			AllocatedMB: bytesToMB(d.allocated),
+		})
+	}
+
+	total := s.dirs[root]
+	warnings := s.warnings
+	if warnings == nil {
+		warnings = make([]string, 0)
+	}
+
+	return &models.DUInfo{
+		Root:             root,
+		TotalApparentMB:  bytesToMB(total.apparent),
+		TotalAllocatedMB: bytesToMB(total.allocated),
+		Files:            s.nfiles,
+		Directories:      int64(len(s.dirs)),
+		TopDirectories:   topDUEntries(dirs, s.opts.Limit),
+		TopFiles:         topDUEntries(s.files, s.opts.Limit),
+		Partial:          s.errors > 0,
+		Errors:           s.errors,
+		Warnings:         warnings,
+	}
+}
+
+// topDUEntries returns the limit largest entries by allocated size
+func topDUEntries(entries []models.DUEntry, limit int) []models.DUEntry {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].AllocatedMB != entries[j].AllocatedMB {
+			return entries[i].AllocatedMB > entries[j].AllocatedMB
+		}
+		if entries[i].ApparentMB != entries[j].ApparentMB {
+			return entries[i].ApparentMB > entries[j].ApparentMB
+		}
+		return entries[i].Path < entries[j].Path
+	})
+
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries
+}
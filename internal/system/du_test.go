@@ -0,0 +1,123 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestGetDUInfo(t *testing.T) {
+	root := t.TempDir()
+
+	writeFile(t, filepath.Join(root, "big", "a.bin"), strings.Repeat("x", 2*1024*1024))
+	writeFile(t, filepath.Join(root, "big", "nested", "b.bin"), strings.Repeat("x", 1024*1024))
+	writeFile(t, filepath.Join(root, "small", "c.txt"), "hello")
+
+	info, err := GetDUInfo(DUOptions{Path: root, Limit: 2, Workers: 4})
+	if err != nil {
+		t.Fatalf("GetDUInfo failed: %v", err)
+	}
+
+	if info.Files != 3 {
+		t.Errorf("Expected 3 files, got %d", info.Files)
+	}
+	if info.Directories != 4 {
+		t.Errorf("Expected 4 directories, got %d", info.Directories)
+	}
+	if info.Partial {
+		t.Errorf("Expected complete scan, got warnings: %v", info.Warnings)
+	}
+	if info.TotalApparentMB < 3.0 {
+		t.Errorf("Expected at least 3 MB apparent, got %.2f", info.TotalApparentMB)
+	}
+
+	if len(info.TopFiles) != 2 || filepath.Base(info.TopFiles[0].Path) != "a.bin" {
+		t.Errorf("Unexpected top files: %+v", info.TopFiles)
+	}
+	if len(info.TopDirectories) != 2 || info.TopDirectories[0].Path != info.Root {
+		t.Errorf("Expected root to be the largest directory, got %+v", info.TopDirectories)
+	}
+	if filepath.Base(info.TopDirectories[1].Path) != "big" {
+		t.Errorf("Expected big to be second largest, got %s", info.TopDirectories[1].Path)
+	}
+}
+
+func TestGetDUInfoHardlinksCountedOnce(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("hardlink detection requires inode numbers")
+	}
+
+	root := t.TempDir()
+	original := filepath.Join(root, "original.bin")
+	writeFile(t, original, strings.Repeat("x", 1024*1024))
+	if err := os.Link(original, filepath.Join(root, "link.bin")); err != nil {
+		t.Skipf("hardlinks not supported: %v", err)
+	}
+
+	info, err := GetDUInfo(DUOptions{Path: root, Limit: 10, Workers: 2})
+	if err != nil {
+		t.Fatalf("GetDUInfo failed: %v", err)
+	}
+
+	if info.Files != 1 {
+		t.Errorf("Expected hardlinked file to be counted once, got %d files", info.Files)
+	}
+	if info.TotalApparentMB > 1.5 {
+		t.Errorf("Expected about 1 MB apparent, got %.2f", info.TotalApparentMB)
+	}
+}
+
+func TestGetDUInfoPermissionDenied(t *testing.T) {
+	if runtime.GOOS == "windows" || os.Geteuid() == 0 {
+		t.Skip("permission checks do not apply")
+	}
+
+	root := t.TempDir()
+	locked := filepath.Join(root, "locked")
+	writeFile(t, filepath.Join(locked, "secret"), "data")
+	writeFile(t, filepath.Join(root, "open", "file"), "data")
+	if err := os.Chmod(locked, 0o000); err != nil {
+		t.Fatalf("Chmod failed: %v", err)
+	}
+	defer os.Chmod(locked, 0o755)
+
+	info, err := GetDUInfo(DUOptions{Path: root, Limit: 10, Workers: 2})
+	if err != nil {
+		t.Fatalf("GetDUInfo failed: %v", err)
+	}
+
+	if !info.Partial || info.Errors != 1 || len(info.Warnings) != 1 {
+		t.Errorf("Expected one warning and partial result, got %+v", info)
+	}
+	if info.Files != 1 {
+		t.Errorf("Expected readable file to be counted, got %d files", info.Files)
+	}
+}
+
+func TestGetDUInfoNotDirectory(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "file")
+	writeFile(t, file, "data")
+
+	if _, err := GetDUInfo(DUOptions{Path: file}); err == nil {
+		t.Errorf("Expected error when path is not a directory")
+	}
+}
+
+func TestGetDUInfoSymlinkRoot(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "data", "file"), "data")
+	link := filepath.Join(dir, "link")
+	if err := os.Symlink(filepath.Join(dir, "data"), link); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+
+	info, err := GetDUInfo(DUOptions{Path: link, Workers: 2})
+	if err != nil {
+		t.Fatalf("Expected a symlink to a directory to be scanned, got %v", err)
+	}
+	if info.Files != 1 {
+		t.Errorf("Expected 1 file under the symlinked root, got %d", info.Files)
+	}
+}
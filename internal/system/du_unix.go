@@ -0,0 +1,26 @@
+//go:build linux || darwin
+// +build linux darwin
+
+package system
+
+import (
+	"os"
+	"syscall"
+)
+
+// statFile extracts device, inode, link count and allocated bytes
+// st_blocks is always counted in 512-byte units
+func statFile(fi os.FileInfo) fileStat {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return fileStat{allocated: uint64(fi.Size())}
+	}
+
+	return fileStat{
+		dev:       uint64(st.Dev),
+		ino:       uint64(st.Ino),
+		nlink:     uint64(st.Nlink),
+		allocated: uint64(st.Blocks) * 512,
+		uid:       st.Uid,
+	}
+}
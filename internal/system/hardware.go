@@ -0,0 +1,69 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+// chassisTypes maps SMBIOS chassis type codes (DSP0134 7.4.1) to names
+var chassisTypes = map[int]string{
+	1: "Other", 2: "Unknown", 3: "Desktop", 4: "Low Profile Desktop",
+	5: "Pizza Box", 6: "Mini Tower", 7: "Tower", 8: "Portable",
+	9: "Laptop", 10: "Notebook", 11: "Hand Held", 12: "Docking Station",
+	13: "All in One", 14: "Sub Notebook", 15: "Space-saving", 16: "Lunch Box",
+	17: "Main Server Chassis", 18: "Expansion Chassis", 19: "SubChassis",
+	20: "Bus Expansion Chassis", 21: "Peripheral Chassis", 22: "RAID Chassis",
+	23: "Rack Mount Chassis", 24: "Sealed-case PC", 25: "Multi-system Chassis",
+	26: "Compact PCI", 27: "Advanced TCA", 28: "Blade", 29: "Blade Enclosure",
+	30: "Tablet", 31: "Convertible", 32: "Detachable", 33: "IoT Gateway",
+	34: "Embedded PC", 35: "Mini PC", 36: "Stick PC",
+}
+
+// GetHardwareInfo reads the DMI/SMBIOS inventory from /sys/class/dmi/id
+func GetHardwareInfo() (*models.HardwareInfo, error) {
+	return readHardwareInfo("/sys/class/dmi/id"), nil
+}
+
+func readHardwareInfo(dir string) *models.HardwareInfo {
+	info := &models.HardwareInfo{Restricted: make([]string, 0)}
+
+	if _, err := os.Stat(dir); err != nil {
+		info.Message = "no DMI/SMBIOS tables exposed by firmware (no /sys/class/dmi/id)"
+		return info
+	}
+	info.Available = true
+
+	// Serials and the UUID are mode 0400; list them rather than leave them blank
+	read := func(file, field string) string {
+		value, err := readSysfsString(filepath.Join(dir, file))
+		if os.IsPermission(err) {
+			info.Restricted = append(info.Restricted, field)
+		}
+		return value
+	}
+
+	info.SystemVendor = read("sys_vendor", "system_vendor")
+	info.ProductName = read("product_name", "product_name")
+	info.ProductVersion = read("product_version", "product_version")
+	info.ProductSerial = read("product_serial", "product_serial")
+	info.ProductUUID = read("product_uuid", "product_uuid")
+	info.BoardVendor = read("board_vendor", "board_vendor")
+	info.BoardName = read("board_name", "board_name")
+	info.BIOSVendor = read("bios_vendor", "bios_vendor")
+	info.BIOSVersion = read("bios_version", "bios_version")
+	info.BIOSDate = read("bios_date", "bios_date")
+	info.ChassisAssetTag = read("chassis_asset_tag", "chassis_asset_tag")
+
+	if code, err := strconv.Atoi(read("chassis_type", "chassis_type")); err == nil {
+		info.ChassisTypeCode = code
+		info.ChassisType = chassisTypes[code]
+		if info.ChassisType == "" {
+			info.ChassisType = "Unknown"
+		}
+	}
+
+	return info
+}
@@ -0,0 +1,73 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadHardwareInfo(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"sys_vendor":        "Dell Inc.\n",
+		"product_name":      "PowerEdge R650\n",
+		"product_version":   "\n",
+		"product_serial":    "7XK2QH3\n",
+		"product_uuid":      "4c4c4544-0058-4b10-8032-b7c04f514833\n",
+		"board_vendor":      "Dell Inc.\n",
+		"board_name":        "0PYXKY\n",
+		"bios_vendor":       "Dell Inc.\n",
+		"bios_version":      "1.10.2\n",
+		"bios_date":         "04/19/2023\n",
+		"chassis_type":      "23\n",
+		"chassis_asset_tag": "IT-004211\n",
+	}
+	for name, content := range files {
+		writeFile(t, filepath.Join(dir, name), content)
+	}
+
+	info := readHardwareInfo(dir)
+	if !info.Available {
+		t.Fatalf("Expected DMI to be available: %s", info.Message)
+	}
+	if info.SystemVendor != "Dell Inc." || info.ProductName != "PowerEdge R650" || info.ProductSerial != "7XK2QH3" {
+		t.Errorf("Unexpected system fields: %+v", info)
+	}
+	if info.ProductUUID != "4c4c4544-0058-4b10-8032-b7c04f514833" || info.BIOSVersion != "1.10.2" || info.BIOSDate != "04/19/2023" {
+		t.Errorf("Unexpected uuid/bios fields: %+v", info)
+	}
+	if info.ChassisTypeCode != 23 || info.ChassisType != "Rack Mount Chassis" || info.ChassisAssetTag != "IT-004211" {
+		t.Errorf("Unexpected chassis fields: %+v", info)
+	}
+	if len(info.Restricted) != 0 {
+		t.Errorf("Expected no restricted fields, got %v", info.Restricted)
+	}
+}
+
+func TestReadHardwareInfoRestricted(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("root can read mode 0400 files")
+	}
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "sys_vendor"), "LENOVO\n")
+	writeFile(t, filepath.Join(dir, "product_serial"), "PF3ABCDE\n")
+	if err := os.Chmod(filepath.Join(dir, "product_serial"), 0); err != nil {
+		t.Fatalf("Chmod failed: %v", err)
+	}
+
+	info := readHardwareInfo(dir)
+	if len(info.Restricted) != 1 || info.Restricted[0] != "product_serial" {
+		t.Errorf("Expected product_serial restricted, got %v", info.Restricted)
+	}
+	if info.SystemVendor != "LENOVO" || info.ProductSerial != "" {
+		t.Errorf("Unexpected fields: %+v", info)
+	}
+}
+
+func TestReadHardwareInfoMissing(t *testing.T) {
+	info := readHardwareInfo(filepath.Join(t.TempDir(), "missing"))
+	if info.Available || info.Message == "" {
+		t.Errorf("Expected unavailable DMI with a message, got %+v", info)
+	}
+}
@@ -0,0 +1,108 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+const (
+	sysHugePagesPath = "/sys/kernel/mm/hugepages"
+	sysTHPPath       = "/sys/kernel/mm/transparent_hugepage"
+	procMeminfoPath  = "/proc/meminfo"
+)
+
+// GetHugePagesInfo returns static hugepage pools plus THP settings and usage
+func GetHugePagesInfo() (*models.HugePagesInfo, error) {
+	info := &models.HugePagesInfo{
+		Pools: readHugePagePools(sysHugePagesPath),
+		THP:   readTHPSettings(sysTHPPath),
+	}
+
+	if data, err := os.ReadFile(procMeminfoPath); err == nil {
+		mem := parseMeminfo(string(data))
+		info.THP.AnonHugePagesMB = float64(mem["AnonHugePages"]) / 1024.0
+		info.THP.ShmemHugePagesMB = float64(mem["ShmemHugePages"]) / 1024.0
+		info.THP.FileHugePagesMB = float64(mem["FileHugePages"]) / 1024.0
+	}
+
+	if data, err := os.ReadFile(procVMStatPath); err == nil {
+		for name, value := range parseVMStat(string(data)) {
+			if strings.HasPrefix(name, "thp_") {
+				info.THP.Counters[name] = value
+			}
+		}
+	}
+
+	return info, nil
+}
+
+// readHugePagePools reads each hugepages-<size>kB directory under root
+func readHugePagePools(root string) []models.HugePagePool {
+	pools := make([]models.HugePagePool, 0)
+
+	matches, err := filepath.Glob(filepath.Join(root, "hugepages-*kB"))
+	if err != nil {
+		return pools
+	}
+
+	for _, dir := range matches {
+		size := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(dir), "hugepages-"), "kB")
+		sizeKB, err := strconv.ParseUint(size, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		read := func(name string) uint64 {
+			v, _ := readSysfsUint(filepath.Join(dir, name))
+			return v
+		}
+
+		pools = append(pools, models.HugePagePool{
+			SizeKB:     sizeKB,
+			Total:      read("nr_hugepages"),
+			Free:       read("free_hugepages"),
+			Reserved:   read("resv_hugepages"),
+			Surplus:    read("surplus_hugepages"),
+			Overcommit: read("nr_overcommit_hugepages"),
+		})
+	}
+
+	sort.Slice(pools, func(i, j int) bool {
+		return pools[i].SizeKB < pools[j].SizeKB
+	})
+
+	return pools
+}
+
+// readTHPSettings reads the selected THP modes under root
+func readTHPSettings(root string) models.THPInfo {
+	thp := models.THPInfo{
+		Counters: make(map[string]uint64),
+	}
+
+	enabled, err := readSysfsString(filepath.Join(root, "enabled"))
+	if err != nil {
+		return thp
+	}
+	thp.Available = true
+	thp.Enabled = parseSelectedOption(enabled)
+
+	read := func(name string) string {
+		value, err := readSysfsString(filepath.Join(root, name))
+		if err != nil {
+			return ""
+		}
+		return parseSelectedOption(value)
+	}
+
+	thp.Defrag = read("defrag")
+	thp.ShmemEnabled = read("shmem_enabled")
+	thp.KhugepagedDefrag = read(filepath.Join("khugepaged", "defrag"))
+
+	return thp
+}
@@ -0,0 +1,53 @@
+package system
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestReadHugePagePools(t *testing.T) {
+	root := t.TempDir()
+	for name, value := range map[string]string{
+		"nr_hugepages":            "512",
+		"free_hugepages":          "100",
+		"resv_hugepages":          "10",
+		"surplus_hugepages":       "2",
+		"nr_overcommit_hugepages": "0",
+	} {
+		writeFile(t, filepath.Join(root, "hugepages-2048kB", name), value+"\n")
+	}
+	writeFile(t, filepath.Join(root, "hugepages-1048576kB", "nr_hugepages"), "4\n")
+
+	pools := readHugePagePools(root)
+	if len(pools) != 2 {
+		t.Fatalf("Expected 2 pools, got %d", len(pools))
+	}
+
+	p := pools[0]
+	if p.SizeKB != 2048 || p.Total != 512 || p.Free != 100 || p.Reserved != 10 || p.Surplus != 2 {
+		t.Errorf("Unexpected 2MB pool: %+v", p)
+	}
+	if pools[1].SizeKB != 1048576 || pools[1].Total != 4 {
+		t.Errorf("Unexpected 1GB pool: %+v", pools[1])
+	}
+}
+
+func TestReadTHPSettings(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "enabled"), "always [madvise] never\n")
+	writeFile(t, filepath.Join(root, "defrag"), "always defer defer+madvise [madvise] never\n")
+	writeFile(t, filepath.Join(root, "shmem_enabled"), "always within_size advise [never] deny force\n")
+	writeFile(t, filepath.Join(root, "khugepaged", "defrag"), "1\n")
+
+	thp := readTHPSettings(root)
+	if !thp.Available || thp.Enabled != "madvise" || thp.Defrag != "madvise" || thp.ShmemEnabled != "never" {
+		t.Errorf("Unexpected THP settings: %+v", thp)
+	}
+	if thp.KhugepagedDefrag != "1" {
+		t.Errorf("Expected khugepaged defrag 1, got %q", thp.KhugepagedDefrag)
+	}
+
+	if missing := readTHPSettings(filepath.Join(root, "missing")); missing.Available {
+		t.Errorf("Expected THP unavailable for missing directory")
+	}
+}
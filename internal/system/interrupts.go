@@ -0,0 +1,164 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+const (
+	procInterruptsPath = "/proc/interrupts"
+	procSoftIRQsPath   = "/proc/softirqs"
+	procIRQPath        = "/proc/irq"
+)
+
+// GetInterruptsInfo returns the limit busiest IRQs and all softirqs with
+// per-CPU counts. When prev is set (watch mode), IRQs are ranked by their
+// per-second rate since prev instead of by total count.
+func GetInterruptsInfo(prev *models.InterruptsInfo, limit int) (*models.InterruptsInfo, error) {
+	data, err := os.ReadFile(procInterruptsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	cpus, irqs := parseInterrupts(string(data))
+	for i := range irqs {
+		if _, err := strconv.Atoi(irqs[i].IRQ); err != nil {
+			// Architecture-specific rows such as LOC or NMI have no affinity
+			continue
+		}
+		affinity, err := readSysfsString(filepath.Join(procIRQPath, irqs[i].IRQ, "smp_affinity_list"))
+		if err != nil {
+			continue
+		}
+		irqs[i].Affinity = affinity
+		// On a single-CPU machine every IRQ trivially targets one CPU
+		irqs[i].Pinned = cpus > 1 && isSingleCPU(affinity)
+	}
+
+	softirqs := make([]models.IRQStat, 0)
+	if data, err := os.ReadFile(procSoftIRQsPath); err == nil {
+		_, softirqs = parseInterrupts(string(data))
+	}
+
+	info := &models.InterruptsInfo{
+		CPUs:       cpus,
+		Interrupts: irqs,
+		SoftIRQs:   softirqs,
+		Totals:     make(map[string]uint64, len(irqs)+len(softirqs)),
+		SampleTime: time.Now(),
+	}
+	for _, irq := range irqs {
+		info.Totals["irq:"+irq.IRQ] = irq.Total
+	}
+	for _, softirq := range softirqs {
+		info.Totals["softirq:"+softirq.IRQ] = softirq.Total
+	}
+
+	if prev != nil {
+		elapsed := info.SampleTime.Sub(prev.SampleTime)
+		applyIRQRates(info.Interrupts, "irq:", prev.Totals, elapsed)
+		applyIRQRates(info.SoftIRQs, "softirq:", prev.Totals, elapsed)
+	}
+
+	sort.SliceStable(info.Interrupts, func(i, j int) bool {
+		a, b := info.Interrupts[i], info.Interrupts[j]
+		if a.PerSecond != nil && b.PerSecond != nil && *a.PerSecond != *b.PerSecond {
+			return *a.PerSecond > *b.PerSecond
+		}
+		return a.Total > b.Total
+	})
+	if len(info.Interrupts) > limit {
+		info.Interrupts = info.Interrupts[:limit]
+	}
+
+	return info, nil
+}
+
+// parseInterrupts parses /proc/interrupts or /proc/softirqs content
+// The header lists one column per CPU; rows are "NAME: count... description"
+func parseInterrupts(data string) (int, []models.IRQStat) {
+	irqs := make([]models.IRQStat, 0)
+
+	lines := strings.Split(data, "\n")
+	if len(lines) == 0 {
+		return 0, irqs
+	}
+	cpus := len(strings.Fields(lines[0]))
+
+	for _, line := range lines[1:] {
+		name, rest, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+
+		fields := strings.Fields(rest)
+		irq := models.IRQStat{
+			IRQ:    strings.TrimSpace(name),
+			PerCPU: make([]uint64, 0, cpus),
+		}
+
+		// Rows like ERR and MIS have a single count rather than one per CPU
+		i := 0
+		for ; i < len(fields) && i < cpus; i++ {
+			v, err := strconv.ParseUint(fields[i], 10, 64)
+			if err != nil {
+				break
+			}
+			irq.PerCPU = append(irq.PerCPU, v)
+			irq.Total += v
+		}
+		irq.Description = strings.Join(fields[i:], " ")
+
+		irqs = append(irqs, irq)
+	}
+
+	return cpus, irqs
+}
+
+// applyIRQRates fills PerSecond from totals recorded in the previous sample
+func applyIRQRates(irqs []models.IRQStat, prefix string, prev map[string]uint64, elapsed time.Duration) {
+	for i := range irqs {
+		if total, ok := prev[prefix+irqs[i].IRQ]; ok {
+			irqs[i].PerSecond = counterRate(irqs[i].Total, total, elapsed)
+		}
+	}
+}
+
+// isSingleCPU reports whether a CPU list such as "3" or "0-7" names one CPU
+func isSingleCPU(list string) bool {
+	cpus := parseCPUList(list)
+	return len(cpus) == 1
+}
+
+// parseCPUList expands a kernel CPU list such as "0-3,8,10-11"
+func parseCPUList(list string) []int {
+	cpus := make([]int, 0)
+
+	for _, part := range strings.Split(strings.TrimSpace(list), ",") {
+		if part == "" {
+			continue
+		}
+		lo, hi, isRange := strings.Cut(part, "-")
+		start, err := strconv.Atoi(lo)
+		if err != nil {
+			continue
+		}
+		end := start
+		if isRange {
+			if end, err = strconv.Atoi(hi); err != nil {
+				continue
+			}
+		}
+		for cpu := start; cpu <= end; cpu++ {
+			cpus = append(cpus, cpu)
+		}
+	}
+
+	return cpus
+}
@@ -0,0 +1,105 @@
+package system
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+const sampleInterrupts = `           CPU0       CPU1       CPU2       CPU3
+  0:         43          0          0          0   IO-APIC   2-edge      timer
+ 24:          0     501234          0          0   PCI-MSI 524288-edge      eth0-TxRx-0
+ 25:        100        200        300        400   PCI-MSI 524289-edge      nvme0q0, nvme1q0
+NMI:          5          6          7          8   Non-maskable interrupts
+LOC:     236089     123456     111111     100000   Local timer interrupts
+ERR:          0
+`
+
+func TestParseInterrupts(t *testing.T) {
+	cpus, irqs := parseInterrupts(sampleInterrupts)
+	if cpus != 4 {
+		t.Fatalf("Expected 4 CPUs, got %d", cpus)
+	}
+	if len(irqs) != 6 {
+		t.Fatalf("Expected 6 IRQs, got %d", len(irqs))
+	}
+
+	eth := irqs[1]
+	if eth.IRQ != "24" || eth.Total != 501234 {
+		t.Errorf("Unexpected eth0 IRQ: %+v", eth)
+	}
+	if eth.Description != "PCI-MSI 524288-edge eth0-TxRx-0" {
+		t.Errorf("Unexpected description %q", eth.Description)
+	}
+	if !reflect.DeepEqual(eth.PerCPU, []uint64{0, 501234, 0, 0}) {
+		t.Errorf("Unexpected per-CPU counts: %v", eth.PerCPU)
+	}
+
+	if irqs[2].Description != "PCI-MSI 524289-edge nvme0q0, nvme1q0" {
+		t.Errorf("Expected shared devices in description, got %q", irqs[2].Description)
+	}
+
+	loc := irqs[4]
+	if loc.IRQ != "LOC" || loc.Description != "Local timer interrupts" || loc.Total != 570656 {
+		t.Errorf("Unexpected LOC row: %+v", loc)
+	}
+
+	errRow := irqs[5]
+	if errRow.IRQ != "ERR" || len(errRow.PerCPU) != 1 || errRow.Total != 0 {
+		t.Errorf("Unexpected ERR row: %+v", errRow)
+	}
+}
+
+func TestParseSoftIRQs(t *testing.T) {
+	data := `                    CPU0       CPU1
+          HI:          0          1
+       TIMER:      23742      10000
+      NET_RX:        150        250
+`
+
+	cpus, softirqs := parseInterrupts(data)
+	if cpus != 2 || len(softirqs) != 3 {
+		t.Fatalf("Expected 2 CPUs and 3 softirqs, got %d and %d", cpus, len(softirqs))
+	}
+	if softirqs[1].IRQ != "TIMER" || softirqs[1].Total != 33742 {
+		t.Errorf("Unexpected TIMER row: %+v", softirqs[1])
+	}
+}
+
+func TestApplyIRQRates(t *testing.T) {
+	_, irqs := parseInterrupts(sampleInterrupts)
+	prev := map[string]uint64{"irq:24": 501000, "irq:LOC": 570000}
+
+	applyIRQRates(irqs, "irq:", prev, 2*time.Second)
+
+	if irqs[1].PerSecond == nil || *irqs[1].PerSecond != 117 {
+		t.Errorf("Expected 117/s for IRQ 24, got %v", irqs[1].PerSecond)
+	}
+	if irqs[0].PerSecond != nil {
+		t.Errorf("Expected no rate for IRQ without previous sample")
+	}
+}
+
+func TestParseCPUList(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []int
+	}{
+		{"0", []int{0}},
+		{"0-3", []int{0, 1, 2, 3}},
+		{"0-1,8,10-11", []int{0, 1, 8, 10, 11}},
+		{"", []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := parseCPUList(tt.input); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("parseCPUList(%q) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+
+	if !isSingleCPU("5") || isSingleCPU("0-7") {
+		t.Errorf("isSingleCPU returned unexpected result")
+	}
+}
@@ -0,0 +1,265 @@
+package system
+
+import (
+	"bufio"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+// shm mode bits reported alongside the permissions in /proc/sysvipc/shm
+const (
+	shmDest   = 01000 // marked for removal once the last process detaches
+	shmLocked = 02000 // locked in memory with SHM_LOCK
+)
+
+// GetIPCInfo reports System V shared memory, semaphores and message queues,
+// plus POSIX shared memory files under /dev/shm
+func GetIPCInfo() (*models.IPCInfo, error) {
+	owners := newOwnerCache()
+	info := &models.IPCInfo{
+		SharedMemory:  readSysVShm("/proc/sysvipc/shm", owners),
+		Semaphores:    readSysVSem("/proc/sysvipc/sem", owners),
+		MessageQueues: readSysVMsg("/proc/sysvipc/msg", owners),
+		DevShm:        readDevShm("/dev/shm", "/proc", owners),
+	}
+
+	for _, seg := range info.SharedMemory {
+		info.ShmTotalBytes += seg.SizeBytes
+		if seg.Orphaned {
+			info.ShmOrphaned++
+		}
+	}
+
+	return info, nil
+}
+
+// readSysVTable parses a /proc/sysvipc file into rows keyed by header name
+func readSysVTable(path string) []map[string]string {
+	rows := make([]map[string]string, 0)
+	f, err := os.Open(path)
+	if err != nil {
+		return rows
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	if !scanner.Scan() {
+		return rows
+	}
+	header := strings.Fields(scanner.Text())
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < len(header) {
+			continue
+		}
+		row := make(map[string]string, len(header))
+		for i, name := range header {
+			row[name] = fields[i]
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func readSysVShm(path string, owners *ownerCache) []models.SysVShm {
+	segments := make([]models.SysVShm, 0)
+	for _, row := range readSysVTable(path) {
+		mode, _ := strconv.ParseUint(row["perms"], 8, 32)
+		seg := models.SysVShm{
+			Key:        formatIPCKey(row["key"]),
+			ID:         atoiField(row["shmid"]),
+			Perms:      fmt.Sprintf("%04o", mode&0777),
+			SizeBytes:  parseUintField(row["size"]),
+			RSSBytes:   parseUintField(row["rss"]),
+			SwapBytes:  parseUintField(row["swap"]),
+			CreatorPID: atoiField(row["cpid"]),
+			LastPID:    atoiField(row["lpid"]),
+			Attached:   atoiField(row["nattch"]),
+			Removed:    mode&shmDest != 0,
+			Locked:     mode&shmLocked != 0,
+		}
+		seg.UID, seg.Owner = owners.lookup(row["uid"])
+		// Nothing attached and not pending removal: persists until ipcrm
+		seg.Orphaned = seg.Attached == 0 && !seg.Removed
+		segments = append(segments, seg)
+	}
+
+	sort.Slice(segments, func(i, j int) bool { return segments[i].SizeBytes > segments[j].SizeBytes })
+	return segments
+}
+
+func readSysVSem(path string, owners *ownerCache) []models.SysVSemaphore {
+	sets := make([]models.SysVSemaphore, 0)
+	for _, row := range readSysVTable(path) {
+		mode, _ := strconv.ParseUint(row["perms"], 8, 32)
+		set := models.SysVSemaphore{
+			Key:   formatIPCKey(row["key"]),
+			ID:    atoiField(row["semid"]),
+			Perms: fmt.Sprintf("%04o", mode&0777),
+			NSems: atoiField(row["nsems"]),
+		}
+		set.UID, set.Owner = owners.lookup(row["uid"])
+		sets = append(sets, set)
+	}
+	return sets
+}
+
+func readSysVMsg(path string, owners *ownerCache) []models.SysVMessageQueue {
+	queues := make([]models.SysVMessageQueue, 0)
+	for _, row := range readSysVTable(path) {
+		mode, _ := strconv.ParseUint(row["perms"], 8, 32)
+		q := models.SysVMessageQueue{
+			Key:         formatIPCKey(row["key"]),
+			ID:          atoiField(row["msqid"]),
+			Perms:       fmt.Sprintf("%04o", mode&0777),
+			Bytes:       parseUintField(row["cbytes"]),
+			Messages:    parseUintField(row["qnum"]),
+			LastSendPID: atoiField(row["lspid"]),
+			LastRecvPID: atoiField(row["lrpid"]),
+		}
+		q.UID, q.Owner = owners.lookup(row["uid"])
+		queues = append(queues, q)
+	}
+	return queues
+}
+
+// readDevShm lists POSIX shared memory files and counts the processes that
+// map or hold them open; files nobody uses are likely leftovers
+func readDevShm(dir, procRoot string, owners *ownerCache) models.DevShmInfo {
+	info := models.DevShmInfo{Path: dir, Files: make([]models.ShmFile, 0)}
+
+	if total, used, avail, ok := shmFilesystemUsage(dir); ok {
+		info.TotalBytes, info.UsedBytes, info.AvailableBytes = total, used, avail
+		if total > 0 {
+			info.UsagePercent = float64(used) / float64(total) * 100
+		}
+	}
+
+	users, unreadable := shmUsers(dir, procRoot)
+	info.UnreadableProcesses = unreadable
+
+	filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+		fi, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		st := statFile(fi)
+		file := models.ShmFile{
+			Path:           path,
+			SizeBytes:      uint64(fi.Size()),
+			AllocatedBytes: st.allocated,
+			Modified:       fi.ModTime().Format(time.RFC3339),
+			Processes:      len(users[path]),
+		}
+		file.UID, file.Owner = owners.lookup(strconv.FormatUint(uint64(st.uid), 10))
+		info.FilesBytes += file.AllocatedBytes
+		if file.Processes == 0 {
+			info.Unused++
+			info.UnusedBytes += file.AllocatedBytes
+		}
+		info.Files = append(info.Files, file)
+		return nil
+	})
+
+	sort.SliceStable(info.Files, func(i, j int) bool { return info.Files[i].AllocatedBytes > info.Files[j].AllocatedBytes })
+	return info
+}
+
+// shmUsers maps each file under dir to the PIDs that map it or have it open
+func shmUsers(dir, procRoot string) (map[string]map[int]bool, int) {
+	users := make(map[string]map[int]bool)
+	add := func(path string, pid int) {
+		if users[path] == nil {
+			users[path] = make(map[int]bool)
+		}
+		users[path][pid] = true
+	}
+	prefix := strings.TrimSuffix(dir, "/") + "/"
+
+	entries, err := os.ReadDir(procRoot)
+	if err != nil {
+		return users, 0
+	}
+
+	unreadable := 0
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		maps, err := os.ReadFile(filepath.Join(procRoot, entry.Name(), "maps"))
+		if err != nil {
+			unreadable++
+			continue
+		}
+		for _, line := range strings.Split(string(maps), "\n") {
+			// The pathname is the sixth column and may contain spaces
+			if i := strings.Index(line, prefix); i >= 0 {
+				add(strings.TrimSuffix(line[i:], " (deleted)"), pid)
+			}
+		}
+
+		fds, _ := os.ReadDir(filepath.Join(procRoot, entry.Name(), "fd"))
+		for _, fd := range fds {
+			if target, err := os.Readlink(filepath.Join(procRoot, entry.Name(), "fd", fd.Name())); err == nil && strings.HasPrefix(target, prefix) {
+				add(strings.TrimSuffix(target, " (deleted)"), pid)
+			}
+		}
+	}
+
+	return users, unreadable
+}
+
+// formatIPCKey renders the signed decimal key from /proc as hex, like ipcs
+func formatIPCKey(value string) string {
+	key, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return value
+	}
+	return fmt.Sprintf("0x%08x", uint32(key))
+}
+
+func atoiField(value string) int {
+	n, _ := strconv.Atoi(value)
+	return n
+}
+
+func parseUintField(value string) uint64 {
+	n, _ := strconv.ParseUint(value, 10, 64)
+	return n
+}
+
+// ownerCache resolves uids to user names once per uid
+type ownerCache struct {
+	names map[string]string
+}
+
+func newOwnerCache() *ownerCache {
+	return &ownerCache{names: make(map[string]string)}
+}
+
+func (c *ownerCache) lookup(uid string) (uint32, string) {
+	n, _ := strconv.ParseUint(uid, 10, 32)
+	name, ok := c.names[uid]
+	if !ok {
+		name = uid
+		if u, err := user.LookupId(uid); err == nil {
+			name = u.Username
+		}
+		c.names[uid] = name
+	}
+	return uint32(n), name
+}
@@ -0,0 +1,121 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadSysVShm(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "shm")
+	writeFile(t, path, `       key      shmid perms                  size  cpid  lpid nattch   uid   gid  cuid  cgid      atime      dtime      ctime                   rss                  swap
+         0          3  1600               8388608  1201  1201      2     0     0     0     0 1700000000 1700000000 1700000000               4194304                     0
+  88050737         32   600            4404019200  2210  2210      0     0     0     0     0 1700000100 1700000200 1700000000            4404019200                  8192
+        -1          7  3644                 65536   900   905      1     0     0     0     0 1700000000          0 1700000000                 65536                     0
+`)
+
+	segments := readSysVShm(path, newOwnerCache())
+	if len(segments) != 3 {
+		t.Fatalf("Expected 3 segments, got %d", len(segments))
+	}
+
+	leak := segments[0]
+	if leak.Key != "0x053f8c31" || leak.ID != 32 || leak.SizeBytes != 4404019200 || leak.SwapBytes != 8192 {
+		t.Errorf("Unexpected largest segment: %+v", leak)
+	}
+	if !leak.Orphaned || leak.Attached != 0 || leak.Perms != "0600" || leak.CreatorPID != 2210 || leak.Owner != "root" {
+		t.Errorf("Expected orphaned root-owned segment, got %+v", leak)
+	}
+
+	removed := segments[1]
+	if !removed.Removed || removed.Orphaned || removed.Key != "0x00000000" || removed.Attached != 2 {
+		t.Errorf("Expected segment pending removal, got %+v", removed)
+	}
+	if locked := segments[2]; !locked.Locked || locked.Perms != "0644" || locked.Key != "0xffffffff" {
+		t.Errorf("Expected locked segment with key 0xffffffff, got %+v", locked)
+	}
+}
+
+func TestReadSysVSemAndMsg(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "sem"), `       key      semid perms      nsems   uid   gid  cuid  cgid      otime      ctime
+   5432001          0   600         17    70    70    70    70 1700000000 1700000000
+`)
+	writeFile(t, filepath.Join(dir, "msg"), `       key      msqid perms      cbytes       qnum lspid lrpid   uid   gid  cuid  cgid      stime      rtime      ctime
+      1234          1   666        2048         16  3100  3101  4242  4242  4242  4242 1700000000 1700000000 1700000000
+`)
+
+	owners := newOwnerCache()
+	sems := readSysVSem(filepath.Join(dir, "sem"), owners)
+	if len(sems) != 1 || sems[0].NSems != 17 || sems[0].UID != 70 || sems[0].Key != "0x0052e2c1" {
+		t.Errorf("Unexpected semaphores: %+v", sems)
+	}
+
+	msgs := readSysVMsg(filepath.Join(dir, "msg"), owners)
+	if len(msgs) != 1 || msgs[0].Bytes != 2048 || msgs[0].Messages != 16 || msgs[0].LastRecvPID != 3101 || msgs[0].Perms != "0666" {
+		t.Errorf("Unexpected message queues: %+v", msgs)
+	}
+	if msgs[0].Owner != "4242" {
+		t.Errorf("Expected unknown uid to be shown numerically, got %q", msgs[0].Owner)
+	}
+
+	if got := readSysVShm(filepath.Join(dir, "missing"), owners); got == nil || len(got) != 0 {
+		t.Errorf("Expected empty segments for a missing file, got %v", got)
+	}
+}
+
+func TestReadDevShm(t *testing.T) {
+	root := t.TempDir()
+	shm := filepath.Join(root, "shm")
+	proc := filepath.Join(root, "proc")
+
+	writeFile(t, filepath.Join(shm, "PostgreSQL.1804289383"), string(make([]byte, 8192)))
+	writeFile(t, filepath.Join(shm, "PostgreSQL.846930886"), string(make([]byte, 4096)))
+	writeFile(t, filepath.Join(shm, "sem.app"), "x")
+
+	writeFile(t, filepath.Join(proc, "1201/maps"),
+		"7f0000000000-7f0000002000 rw-s 00000000 00:19 12 "+filepath.Join(shm, "PostgreSQL.1804289383")+"\n"+
+			"7f0000003000-7f0000004000 r-xp 00000000 08:01 99 /usr/lib/libc.so.6\n")
+	writeFile(t, filepath.Join(proc, "1202/maps"), "")
+	if err := os.MkdirAll(filepath.Join(proc, "1202/fd"), 0o755); err != nil {
+		t.Fatalf("MkdirAll failed: %v", err)
+	}
+	if err := os.Symlink(filepath.Join(shm, "PostgreSQL.1804289383"), filepath.Join(proc, "1202/fd/5")); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+	if err := os.Symlink(filepath.Join(shm, "sem.app")+" (deleted)", filepath.Join(proc, "1202/fd/6")); err != nil {
+		t.Fatalf("Symlink failed: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(proc, "1300"), 0o755); err != nil {
+		t.Fatalf("MkdirAll failed: %v", err)
+	}
+
+	info := readDevShm(shm, proc, newOwnerCache())
+	if len(info.Files) != 3 {
+		t.Fatalf("Expected 3 files, got %+v", info.Files)
+	}
+	if info.UnreadableProcesses != 1 {
+		t.Errorf("Expected 1 unreadable process, got %d", info.UnreadableProcesses)
+	}
+
+	byName := make(map[string]int)
+	var unusedBytes uint64
+	for _, f := range info.Files {
+		byName[filepath.Base(f.Path)] = f.Processes
+		if f.Processes == 0 {
+			unusedBytes = f.AllocatedBytes
+		}
+	}
+	if byName["PostgreSQL.1804289383"] != 2 || byName["sem.app"] != 1 || byName["PostgreSQL.846930886"] != 0 {
+		t.Errorf("Unexpected process counts: %v", byName)
+	}
+	if info.Files[0].SizeBytes != 8192 || info.Files[0].Modified == "" {
+		t.Errorf("Expected largest file first, got %+v", info.Files[0])
+	}
+	if info.Unused != 1 || info.UnusedBytes != unusedBytes {
+		t.Errorf("Expected the unmapped PostgreSQL segment to be unused, got %d (%d bytes)", info.Unused, info.UnusedBytes)
+	}
+	if info.TotalBytes == 0 {
+		t.Errorf("Expected filesystem totals from statfs")
+	}
+}
@@ -0,0 +1,19 @@
+//go:build linux || darwin
+// +build linux darwin
+
+package system
+
+import "golang.org/x/sys/unix"
+
+// shmFilesystemUsage returns total, used and available bytes of the tmpfs at path
+func shmFilesystemUsage(path string) (total, used, avail uint64, ok bool) {
+	var stat unix.Statfs_t
+	if err := unix.Statfs(path, &stat); err != nil {
+		return 0, 0, 0, false
+	}
+	bsize := uint64(stat.Bsize)
+	total = uint64(stat.Blocks) * bsize
+	avail = uint64(stat.Bavail) * bsize
+	used = total - uint64(stat.Bfree)*bsize
+	return total, used, avail, true
+}
@@ -0,0 +1,9 @@
+//go:build windows
+// +build windows
+
+package system
+
+// shmFilesystemUsage is unavailable on Windows, which has no /dev/shm
+func shmFilesystemUsage(path string) (total, used, avail uint64, ok bool) {
+	return 0, 0, 0, false
+}
@@ -0,0 +1,147 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+// kernelTaintFlags describes each bit of /proc/sys/kernel/tainted, indexed by bit
+// See Documentation/admin-guide/tainted-kernels.rst
+var kernelTaintFlags = []struct {
+	letter      string
+	description string
+}{
+	{"P", "proprietary module was loaded"},
+	{"F", "module was force loaded"},
+	{"S", "kernel running on an out of specification system"},
+	{"R", "module was force unloaded"},
+	{"M", "processor reported a machine check exception"},
+	{"B", "bad page referenced or unexpected page flags"},
+	{"U", "taint requested by userspace"},
+	{"D", "kernel died recently (OOPS or BUG)"},
+	{"A", "ACPI table overridden by user"},
+	{"W", "kernel issued warning"},
+	{"C", "staging driver was loaded"},
+	{"I", "workaround for platform firmware bug applied"},
+	{"O", "externally-built (out-of-tree) module was loaded"},
+	{"E", "unsigned module was loaded"},
+	{"L", "soft lockup occurred"},
+	{"K", "kernel has been live patched"},
+	{"X", "auxiliary taint, defined for and used by distros"},
+	{"T", "kernel was built with the struct randomization plugin"},
+	{"N", "an in-kernel test has been run"},
+	{"J", "userspace used a mutating debug operation in fwctl"},
+}
+
+// GetKernelInfo returns the kernel command line, boot time and taint state
+func GetKernelInfo() (*models.KernelInfo, error) {
+	return readKernelInfo("/proc")
+}
+
+func readKernelInfo(root string) (*models.KernelInfo, error) {
+	cmdline, err := os.ReadFile(filepath.Join(root, "cmdline"))
+	if err != nil {
+		return nil, err
+	}
+
+	info := &models.KernelInfo{
+		CommandLine: strings.TrimSpace(string(cmdline)),
+		TaintFlags:  make([]models.TaintFlag, 0),
+	}
+	info.Parameters, info.InitArgs = parseCmdline(info.CommandLine)
+
+	info.Release, _ = readSysfsString(filepath.Join(root, "sys/kernel/osrelease"))
+	info.Version, _ = readSysfsString(filepath.Join(root, "sys/kernel/version"))
+
+	if data, err := os.ReadFile(filepath.Join(root, "stat")); err == nil {
+		if btime := firstValue(parseProcStat(string(data))["btime"]); btime > 0 {
+			info.BootTimeUnix = int64(btime)
+			info.BootTime = time.Unix(int64(btime), 0).UTC().Format(time.RFC3339)
+		}
+	}
+
+	if tainted, err := readSysfsUint(filepath.Join(root, "sys/kernel/tainted")); err == nil {
+		info.Tainted = tainted
+		info.TaintFlags = decodeKernelTaint(tainted)
+	}
+
+	return info, nil
+}
+
+// parseCmdline splits a kernel command line into parameters, honouring
+// double quotes. Everything after a bare "--" is passed to init.
+func parseCmdline(cmdline string) ([]models.BootParameter, []string) {
+	params := make([]models.BootParameter, 0)
+	initArgs := make([]string, 0)
+
+	tokens := splitCmdline(cmdline)
+	for i, token := range tokens {
+		if token == "--" {
+			initArgs = append(initArgs, tokens[i+1:]...)
+			break
+		}
+
+		key, value, _ := strings.Cut(token, "=")
+		params = append(params, models.BootParameter{
+			Key:   key,
+			Value: value,
+		})
+	}
+
+	return params, initArgs
+}
+
+// splitCmdline splits on whitespace outside double quotes and drops the quotes
+// e.g. `dyndbg="file foo.c +p" quiet` -> ["dyndbg=file foo.c +p", "quiet"]
+func splitCmdline(cmdline string) []string {
+	tokens := make([]string, 0)
+
+	var current strings.Builder
+	inQuotes, inToken := false, false
+	for _, r := range cmdline {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			inToken = true
+		case (r == ' ' || r == '\t' || r == '\n') && !inQuotes:
+			if inToken {
+				tokens = append(tokens, current.String())
+				current.Reset()
+				inToken = false
+			}
+		default:
+			current.WriteRune(r)
+			inToken = true
+		}
+	}
+	if inToken {
+		tokens = append(tokens, current.String())
+	}
+
+	return tokens
+}
+
+// decodeKernelTaint names each bit set in the tainted bitmask
+func decodeKernelTaint(tainted uint64) []models.TaintFlag {
+	flags := make([]models.TaintFlag, 0)
+
+	for bit := 0; bit < 64; bit++ {
+		if tainted&(1<<uint(bit)) == 0 {
+			continue
+		}
+
+		flag := models.TaintFlag{Bit: bit, Letter: "?", Description: "unknown taint bit " + strconv.Itoa(bit)}
+		if bit < len(kernelTaintFlags) {
+			flag.Letter = kernelTaintFlags[bit].letter
+			flag.Description = kernelTaintFlags[bit].description
+		}
+		flags = append(flags, flag)
+	}
+
+	return flags
+}
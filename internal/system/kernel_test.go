@@ -0,0 +1,84 @@
+package system
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+func TestParseCmdline(t *testing.T) {
+	params, initArgs := parseCmdline(`BOOT_IMAGE=/vmlinuz-6.8 root=UUID=1234 ro quiet dyndbg="file foo.c +p" console=tty0 console=ttyS0,115200 -- single --verbose`)
+
+	want := []models.BootParameter{
+		{Key: "BOOT_IMAGE", Value: "/vmlinuz-6.8"},
+		{Key: "root", Value: "UUID=1234"},
+		{Key: "ro"},
+		{Key: "quiet"},
+		{Key: "dyndbg", Value: "file foo.c +p"},
+		{Key: "console", Value: "tty0"},
+		{Key: "console", Value: "ttyS0,115200"},
+	}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("Unexpected parameters:\n got %+v\nwant %+v", params, want)
+	}
+	if !reflect.DeepEqual(initArgs, []string{"single", "--verbose"}) {
+		t.Errorf("Unexpected init args %v", initArgs)
+	}
+}
+
+func TestDecodeKernelTaint(t *testing.T) {
+	tests := []struct {
+		name    string
+		tainted uint64
+		letters []string
+	}{
+		{"clean", 0, []string{}},
+		{"proprietary and out-of-tree", 1<<0 | 1<<12 | 1<<13, []string{"P", "O", "E"}},
+		{"machine check and warning", 1<<4 | 1<<9, []string{"M", "W"}},
+		{"unknown bit", 1 << 40, []string{"?"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			letters := make([]string, 0)
+			for _, f := range decodeKernelTaint(tt.tainted) {
+				letters = append(letters, f.Letter)
+			}
+			if !reflect.DeepEqual(letters, tt.letters) {
+				t.Errorf("Got %v, want %v", letters, tt.letters)
+			}
+		})
+	}
+}
+
+func TestReadKernelInfo(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "cmdline"), "ro quiet\n")
+	writeFile(t, filepath.Join(root, "stat"), "cpu  1 2 3 4\nctxt 100\nbtime 1767225600\nprocesses 10\n")
+	writeFile(t, filepath.Join(root, "sys/kernel/tainted"), "4097\n")
+	writeFile(t, filepath.Join(root, "sys/kernel/osrelease"), "6.8.0-45-generic\n")
+
+	info, err := readKernelInfo(root)
+	if err != nil {
+		t.Fatalf("readKernelInfo failed: %v", err)
+	}
+
+	if info.CommandLine != "ro quiet" || len(info.Parameters) != 2 {
+		t.Errorf("Unexpected command line %q / %+v", info.CommandLine, info.Parameters)
+	}
+	if info.BootTime != "2026-01-01T00:00:00Z" || info.BootTimeUnix != 1767225600 {
+		t.Errorf("Unexpected boot time %s (%d)", info.BootTime, info.BootTimeUnix)
+	}
+	if info.Tainted != 4097 || len(info.TaintFlags) != 2 || info.TaintFlags[1].Letter != "O" {
+		t.Errorf("Unexpected taint %d %+v", info.Tainted, info.TaintFlags)
+	}
+	if info.Release != "6.8.0-45-generic" {
+		t.Errorf("Unexpected release %q", info.Release)
+	}
+
+	if _, err := readKernelInfo(t.TempDir()); err == nil {
+		t.Errorf("Expected error without /proc/cmdline")
+	}
+}
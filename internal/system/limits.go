@@ -0,0 +1,219 @@
+package system
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+// GetKernelLimits returns system-wide kernel table usage against its limits
+func GetKernelLimits() ([]models.KernelLimit, error) {
+	return readKernelLimits("/proc"), nil
+}
+
+// readKernelLimits collects each table from a procfs mounted at root
+// Tables whose files are missing are left out rather than reported as zero
+func readKernelLimits(root string) []models.KernelLimit {
+	limits := make([]models.KernelLimit, 0)
+
+	// file-nr: allocated, free (always 0 since 2.6), maximum
+	if fields := readFields(filepath.Join(root, "sys/fs/file-nr")); len(fields) >= 3 {
+		allocated, _ := strconv.ParseUint(fields[0], 10, 64)
+		free, _ := strconv.ParseUint(fields[1], 10, 64)
+		max, _ := strconv.ParseUint(fields[2], 10, 64)
+		limits = append(limits, newKernelLimit("file_handles", allocated-free, max, "system", ""))
+	}
+
+	// inode-nr: allocated, free; modern kernels have no inode limit
+	if fields := readFields(filepath.Join(root, "sys/fs/inode-nr")); len(fields) >= 2 {
+		allocated, _ := strconv.ParseUint(fields[0], 10, 64)
+		free, _ := strconv.ParseUint(fields[1], 10, 64)
+		limits = append(limits, newKernelLimit("inodes", allocated-free, 0, "system", "no kernel limit; grows with memory"))
+	}
+
+	// Every thread consumes a PID, so both tables are measured against tasks
+	tasks, tasksOK := readTaskCount(filepath.Join(root, "loadavg"))
+	if pidMax, err := readSysfsUint(filepath.Join(root, "sys/kernel/pid_max")); err == nil && tasksOK {
+		limits = append(limits, newKernelLimit("pids", tasks, pidMax, "system", "processes and threads each use a PID"))
+	}
+	if threadsMax, err := readSysfsUint(filepath.Join(root, "sys/kernel/threads-max")); err == nil && tasksOK {
+		limits = append(limits, newKernelLimit("threads", tasks, threadsMax, "system", ""))
+	}
+
+	maxWatches, watchErr := readSysfsUint(filepath.Join(root, "sys/fs/inotify/max_user_watches"))
+	maxInstances, instErr := readSysfsUint(filepath.Join(root, "sys/fs/inotify/max_user_instances"))
+	if watchErr == nil || instErr == nil {
+		usage := scanInotifyUsage(root)
+		if watchErr == nil {
+			uid, watches := usage.busiest(func(u inotifyUsage) uint64 { return u.watches })
+			limits = append(limits, newKernelLimit("inotify_watches", watches, maxWatches, "per-user", busiestUserNote(uid, watches)))
+		}
+		if instErr == nil {
+			uid, instances := usage.busiest(func(u inotifyUsage) uint64 { return u.instances })
+			limits = append(limits, newKernelLimit("inotify_instances", instances, maxInstances, "per-user", busiestUserNote(uid, instances)))
+		}
+	}
+
+	avail, availErr := readSysfsUint(filepath.Join(root, "sys/kernel/random/entropy_avail"))
+	poolSize, poolErr := readSysfsUint(filepath.Join(root, "sys/kernel/random/poolsize"))
+	if availErr == nil && poolErr == nil {
+		// Report the depleted part of the pool so high utilization means low
+		// entropy; since 5.18 entropy_avail always equals poolsize (0% used)
+		used := poolSize - min(avail, poolSize)
+		limits = append(limits, newKernelLimit("entropy_bits", used, poolSize, "system",
+			fmt.Sprintf("%d bits available", avail)))
+	}
+
+	return limits
+}
+
+// newKernelLimit builds an entry, leaving utilization unset when there is no limit
+func newKernelLimit(name string, used, limit uint64, scope, note string) models.KernelLimit {
+	entry := models.KernelLimit{
+		Name:  name,
+		Used:  used,
+		Limit: limit,
+		Scope: scope,
+		Note:  note,
+	}
+	if limit > 0 {
+		utilization := float64(used) / float64(limit) * 100
+		entry.UtilizationPercent = &utilization
+	}
+	return entry
+}
+
+// readFields returns the whitespace-separated fields of a small proc file
+func readFields(path string) []string {
+	value, err := readSysfsString(path)
+	if err != nil {
+		return nil
+	}
+	return strings.Fields(value)
+}
+
+// readTaskCount returns the number of scheduling entities from /proc/loadavg
+// Format: 0.06 0.15 0.14 running/total lastpid
+func readTaskCount(path string) (uint64, bool) {
+	fields := readFields(path)
+	if len(fields) < 4 {
+		return 0, false
+	}
+	_, total, ok := strings.Cut(fields[3], "/")
+	if !ok {
+		return 0, false
+	}
+	count, err := strconv.ParseUint(total, 10, 64)
+	return count, err == nil
+}
+
+// inotifyUsage counts inotify instances and watches held by one user
+type inotifyUsage struct {
+	instances uint64
+	watches   uint64
+}
+
+type inotifyUsageByUID map[int]inotifyUsage
+
+// busiest returns the user with the highest value of metric
+func (u inotifyUsageByUID) busiest(metric func(inotifyUsage) uint64) (int, uint64) {
+	uid, max := -1, uint64(0)
+	for id, usage := range u {
+		if v := metric(usage); v > max || (v == max && (uid < 0 || id < uid)) {
+			uid, max = id, v
+		}
+	}
+	return uid, max
+}
+
+func busiestUserNote(uid int, used uint64) string {
+	if uid < 0 || used == 0 {
+		return ""
+	}
+	return fmt.Sprintf("highest user: uid %d", uid)
+}
+
+// scanInotifyUsage walks /proc/<pid>/fd looking for inotify descriptors
+// and counts their watches from the matching fdinfo entries
+func scanInotifyUsage(root string) inotifyUsageByUID {
+	usage := make(inotifyUsageByUID)
+
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return usage
+	}
+
+	for _, entry := range entries {
+		if _, err := strconv.Atoi(entry.Name()); err != nil {
+			continue
+		}
+		pidDir := filepath.Join(root, entry.Name())
+
+		fds, err := os.ReadDir(filepath.Join(pidDir, "fd"))
+		if err != nil {
+			continue
+		}
+
+		uid := -1
+		for _, fd := range fds {
+			target, err := os.Readlink(filepath.Join(pidDir, "fd", fd.Name()))
+			if err != nil || target != "anon_inode:inotify" {
+				continue
+			}
+			if uid < 0 {
+				uid = readProcessUID(filepath.Join(pidDir, "status"))
+			}
+
+			u := usage[uid]
+			u.instances++
+			u.watches += countInotifyWatches(filepath.Join(pidDir, "fdinfo", fd.Name()))
+			usage[uid] = u
+		}
+	}
+
+	return usage
+}
+
+// countInotifyWatches counts "inotify wd:" lines in an fdinfo file
+func countInotifyWatches(path string) uint64 {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0
+	}
+	defer file.Close()
+
+	count := uint64(0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if strings.HasPrefix(scanner.Text(), "inotify wd:") {
+			count++
+		}
+	}
+	return count
+}
+
+// readProcessUID returns the real UID from a /proc/<pid>/status file
+func readProcessUID(statusPath string) int {
+	data, err := os.ReadFile(statusPath)
+	if err != nil {
+		return -1
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.HasPrefix(line, "Uid:") {
+			fields := strings.Fields(line)
+			if len(fields) > 1 {
+				if uid, err := strconv.Atoi(fields[1]); err == nil {
+					return uid
+				}
+			}
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,97 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+func limitByName(limits []models.KernelLimit, name string) *models.KernelLimit {
+	for i := range limits {
+		if limits[i].Name == name {
+			return &limits[i]
+		}
+	}
+	return nil
+}
+
+func TestReadKernelLimits(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "sys/fs/file-nr"), "1000\t0\t4000\n")
+	writeFile(t, filepath.Join(root, "sys/fs/inode-nr"), "15197\t197\n")
+	writeFile(t, filepath.Join(root, "sys/kernel/pid_max"), "32768\n")
+	writeFile(t, filepath.Join(root, "sys/kernel/threads-max"), "1000\n")
+	writeFile(t, filepath.Join(root, "loadavg"), "0.06 0.15 0.14 2/500 11279\n")
+	writeFile(t, filepath.Join(root, "sys/fs/inotify/max_user_watches"), "100\n")
+	writeFile(t, filepath.Join(root, "sys/fs/inotify/max_user_instances"), "8\n")
+	writeFile(t, filepath.Join(root, "sys/kernel/random/entropy_avail"), "256\n")
+	writeFile(t, filepath.Join(root, "sys/kernel/random/poolsize"), "256\n")
+
+	// Process 42 (uid 1000) holds one inotify instance with three watches
+	writeFile(t, filepath.Join(root, "42/status"), "Name:\tsyncer\nUid:\t1000\t1000\t1000\t1000\n")
+	writeFile(t, filepath.Join(root, "42/fdinfo/5"), "pos:\t0\nflags:\t00\n"+
+		"inotify wd:1 ino:2 sdev:3 mask:4\ninotify wd:2 ino:3 sdev:3 mask:4\ninotify wd:3 ino:4 sdev:3 mask:4\n")
+	if err := os.MkdirAll(filepath.Join(root, "42/fd"), 0o755); err != nil {
+		t.Fatalf("MkdirAll failed: %v", err)
+	}
+	if err := os.Symlink("anon_inode:inotify", filepath.Join(root, "42/fd/5")); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+	if err := os.Symlink("/dev/null", filepath.Join(root, "42/fd/0")); err != nil {
+		t.Fatalf("Symlink failed: %v", err)
+	}
+
+	limits := readKernelLimits(root)
+
+	tests := []struct {
+		name        string
+		used        uint64
+		limit       uint64
+		utilization float64
+	}{
+		{"file_handles", 1000, 4000, 25},
+		{"pids", 500, 32768, 500.0 / 32768 * 100},
+		{"threads", 500, 1000, 50},
+		{"inotify_watches", 3, 100, 3},
+		{"inotify_instances", 1, 8, 12.5},
+		{"entropy_bits", 0, 256, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := limitByName(limits, tt.name)
+			if l == nil {
+				t.Fatalf("Missing limit %s", tt.name)
+			}
+			if l.Used != tt.used || l.Limit != tt.limit {
+				t.Errorf("Got used=%d limit=%d, want %d/%d", l.Used, l.Limit, tt.used, tt.limit)
+			}
+			if l.UtilizationPercent == nil || *l.UtilizationPercent != tt.utilization {
+				t.Errorf("Got utilization %v, want %.2f", l.UtilizationPercent, tt.utilization)
+			}
+		})
+	}
+
+	inodes := limitByName(limits, "inodes")
+	if inodes == nil || inodes.Used != 15000 || inodes.UtilizationPercent != nil {
+		t.Errorf("Expected inodes without utilization, got %+v", inodes)
+	}
+
+	// Pre-5.18 kernels can run low: 64 of 256 bits available is 75% used
+	writeFile(t, filepath.Join(root, "sys/kernel/random/entropy_avail"), "64\n")
+	if entropy := limitByName(readKernelLimits(root), "entropy_bits"); entropy.Used != 192 || *entropy.UtilizationPercent != 75 {
+		t.Errorf("Expected 192 bits used (75%%), got %+v", entropy)
+	}
+
+	if note := limitByName(limits, "inotify_watches").Note; note != "highest user: uid 1000" {
+		t.Errorf("Unexpected inotify note %q", note)
+	}
+}
+
+func TestReadKernelLimitsMissing(t *testing.T) {
+	if limits := readKernelLimits(t.TempDir()); len(limits) != 0 {
+		t.Errorf("Expected no limits for empty procfs, got %d", len(limits))
+	}
+}
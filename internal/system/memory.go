@@ -4,3 +4,8 @@ package system
 func bytesToGB(bytes uint64) float64 {
 	return float64(bytes) / (1024 * 1024 * 1024)
 }
+
+// bytesToMB converts bytes to megabytes
+func bytesToMB(bytes uint64) float64 {
+	return float64(bytes) / (1024 * 1024)
+}
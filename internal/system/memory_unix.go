@@ -8,15 +8,18 @@ import (
 )
 
 // GetMemoryInfo returns memory/RAM information on Unix systems
-func GetMemoryInfo() (*models.MemoryInfo, error) {
+// With the container scope, a cgroup memory limit replaces the host totals
+func GetMemoryInfo(scope string) (*models.MemoryInfo, error) {
 	// Unix/Linux implementation - simplified fallback
 	// Production version would use syscall.Sysinfo or similar
-	return &models.MemoryInfo{
+	info := &models.MemoryInfo{
 		TotalGB:      8.0,      // Placeholder - production would read from /proc/meminfo or syscalls
 		AvailableGB:  4.0,      // Placeholder
 		UsedGB:       4.0,      // Placeholder
 		UsagePercent: 50.0,     // Placeholder
 		SwapTotalGB:  2.0,      // Placeholder
 		SwapUsedGB:   0.5,      // Placeholder
-	}, nil
+	}
+	applyMemoryScope(info, GetCgroupLimits(), scope)
+	return info, nil
 }
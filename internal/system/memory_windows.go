@@ -11,7 +11,8 @@ import (
 )
 
 // GetMemoryInfo returns memory/RAM information on Windows
-func GetMemoryInfo() (*models.MemoryInfo, error) {
+// scope is accepted for parity with Unix; there are no cgroups to apply
+func GetMemoryInfo(scope string) (*models.MemoryInfo, error) {
 	kernel32 := syscall.NewLazyDLL("kernel32.dll")
 	globalMemoryStatusEx := kernel32.NewProc("GlobalMemoryStatusEx")
 
@@ -0,0 +1,136 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+const (
+	procModulesPath = "/proc/modules"
+	sysModulePath   = "/sys/module"
+)
+
+// moduleTaintFlags decodes the per-module taint letters shown in /proc/modules
+var moduleTaintFlags = map[rune]string{
+	'P': "proprietary",
+	'O': "out-of-tree",
+	'E': "unsigned",
+	'F': "forced",
+	'C': "staging",
+	'X': "auxiliary",
+	'K': "livepatch",
+	'T': "randstruct-test",
+}
+
+// GetModulesInfo returns loaded kernel modules, limited to names containing
+// any of the given substrings when set
+func GetModulesInfo(names []string) (*models.ModulesInfo, error) {
+	return readModules(procModulesPath, sysModulePath, names)
+}
+
+func readModules(procPath, sysRoot string, names []string) (*models.ModulesInfo, error) {
+	info := &models.ModulesInfo{
+		Modules: make([]models.KernelModule, 0),
+	}
+
+	data, err := os.ReadFile(procPath)
+	if os.IsNotExist(err) {
+		info.Message = "loadable modules are not supported by this kernel (no /proc/modules)"
+		return info, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	info.Available = true
+
+	for _, m := range parseProcModules(string(data)) {
+		if !matchesAnyName(m.Name, names) {
+			continue
+		}
+
+		// Only set for modules built with MODULE_VERSION or out-of-tree builds
+		m.Version, _ = readSysfsString(filepath.Join(sysRoot, m.Name, "version"))
+		m.SrcVersion, _ = readSysfsString(filepath.Join(sysRoot, m.Name, "srcversion"))
+
+		if m.Taint != "" {
+			info.Tainted++
+		}
+		info.Modules = append(info.Modules, m)
+	}
+
+	sort.Slice(info.Modules, func(i, j int) bool {
+		return info.Modules[i].Name < info.Modules[j].Name
+	})
+
+	return info, nil
+}
+
+// parseProcModules parses /proc/modules
+// Format: name size refcount deps state address [(taint)]
+// e.g. nvidia 35000000 100 nvidia_modeset,nvidia_uvm, Live 0xffffffffc0000000 (POE)
+func parseProcModules(data string) []models.KernelModule {
+	modules := make([]models.KernelModule, 0)
+
+	for _, line := range strings.Split(data, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 5 {
+			continue
+		}
+
+		m := models.KernelModule{
+			Name:   fields[0],
+			UsedBy: make([]string, 0),
+			State:  fields[4],
+		}
+		m.SizeBytes, _ = strconv.ParseUint(fields[1], 10, 64)
+		// Refcount is "-" when the kernel was built without module unloading
+		m.RefCount, _ = strconv.Atoi(fields[2])
+
+		if fields[3] != "-" {
+			for _, dep := range strings.Split(fields[3], ",") {
+				if dep != "" {
+					m.UsedBy = append(m.UsedBy, dep)
+				}
+			}
+		}
+
+		if last := fields[len(fields)-1]; len(fields) > 6 && strings.HasPrefix(last, "(") {
+			m.Taint = strings.Trim(last, "()")
+			m.TaintFlags = decodeModuleTaint(m.Taint)
+		}
+
+		modules = append(modules, m)
+	}
+
+	return modules
+}
+
+func decodeModuleTaint(taint string) []string {
+	flags := make([]string, 0, len(taint))
+	for _, c := range taint {
+		if name, ok := moduleTaintFlags[c]; ok {
+			flags = append(flags, name)
+		} else {
+			flags = append(flags, string(c))
+		}
+	}
+	return flags
+}
+
+// matchesAnyName reports whether name contains any of the filters
+func matchesAnyName(name string, filters []string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	for _, f := range filters {
+		if strings.Contains(name, f) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package system
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const testProcModules = `nvidia_uvm 1974272 0 - Live 0xffffffffc1a00000 (POE)
+nvidia 56717312 2 nvidia_uvm,nvidia_modeset, Live 0xffffffffc0000000 (POE)
+ext4 1105920 1 - Live 0x0000000000000000
+loop 36864 0 - Unloading 0xffffffffc0a10000
+`
+
+func TestParseProcModules(t *testing.T) {
+	modules := parseProcModules(testProcModules)
+	if len(modules) != 4 {
+		t.Fatalf("Expected 4 modules, got %d", len(modules))
+	}
+
+	nvidia := modules[1]
+	if nvidia.Name != "nvidia" || nvidia.SizeBytes != 56717312 || nvidia.RefCount != 2 || nvidia.State != "Live" {
+		t.Errorf("Unexpected module %+v", nvidia)
+	}
+	if !reflect.DeepEqual(nvidia.UsedBy, []string{"nvidia_uvm", "nvidia_modeset"}) {
+		t.Errorf("Unexpected dependents %v", nvidia.UsedBy)
+	}
+	if nvidia.Taint != "POE" || !reflect.DeepEqual(nvidia.TaintFlags, []string{"proprietary", "out-of-tree", "unsigned"}) {
+		t.Errorf("Unexpected taint %q %v", nvidia.Taint, nvidia.TaintFlags)
+	}
+
+	if ext4 := modules[2]; ext4.Taint != "" || len(ext4.UsedBy) != 0 {
+		t.Errorf("Expected untainted ext4 without dependents, got %+v", ext4)
+	}
+	if modules[3].State != "Unloading" {
+		t.Errorf("Expected loop to be unloading, got %s", modules[3].State)
+	}
+}
+
+func TestReadModules(t *testing.T) {
+	dir := t.TempDir()
+	procPath := filepath.Join(dir, "modules")
+	sysRoot := filepath.Join(dir, "sys")
+	writeFile(t, procPath, testProcModules)
+	writeFile(t, filepath.Join(sysRoot, "nvidia/version"), "550.54.14\n")
+	writeFile(t, filepath.Join(sysRoot, "nvidia/srcversion"), "6F2C8B6A5F6B3E1D0C9A4B2\n")
+
+	info, err := readModules(procPath, sysRoot, []string{"nvidia"})
+	if err != nil {
+		t.Fatalf("readModules failed: %v", err)
+	}
+	if !info.Available || len(info.Modules) != 2 || info.Tainted != 2 {
+		t.Fatalf("Expected 2 tainted nvidia modules, got %+v", info)
+	}
+	if m := info.Modules[0]; m.Name != "nvidia" || m.Version != "550.54.14" || m.SrcVersion != "6F2C8B6A5F6B3E1D0C9A4B2" {
+		t.Errorf("Expected sorted modules with version info, got %+v", m)
+	}
+
+	info, err = readModules(filepath.Join(dir, "missing"), sysRoot, nil)
+	if err != nil || info.Available || info.Message == "" {
+		t.Errorf("Expected unavailable result without /proc/modules, got %+v, %v", info, err)
+	}
+}
@@ -0,0 +1,152 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+// NamespaceTypes lists the /proc/[pid]/ns entries reported, in display order
+var NamespaceTypes = []string{"mnt", "net", "pid", "uts", "ipc", "user", "cgroup", "time"}
+
+// initNamespaceInodes are the fixed proc inode numbers of the initial
+// namespaces (PROC_*_INIT_INO); mnt and net are allocated dynamically
+var initNamespaceInodes = map[string]uint64{
+	"ipc":    4026531839,
+	"uts":    4026531838,
+	"user":   4026531837,
+	"pid":    4026531836,
+	"cgroup": 4026531835,
+	"time":   4026531834,
+}
+
+// GetNamespacesInfo groups every readable process by namespace for each type
+func GetNamespacesInfo() (*models.NamespacesInfo, error) {
+	return scanNamespaces("/proc", namespaceOwner), nil
+}
+
+// scanNamespaces reads procRoot/[pid]/ns/* links; owner returns the owning
+// user namespace inode of an ns file, or 0 when it cannot be determined
+func scanNamespaces(procRoot string, owner func(path, nsType string) uint64) *models.NamespacesInfo {
+	info := &models.NamespacesInfo{
+		Namespaces: make([]models.Namespace, 0),
+		Counts:     make(map[string]int),
+	}
+
+	entries, err := os.ReadDir(procRoot)
+	if err != nil {
+		return info
+	}
+
+	type key struct {
+		nsType string
+		inode  uint64
+	}
+	byKey := make(map[key]*models.Namespace)
+	examplePath := make(map[key]string)
+	// Reference namespaces from PID 1, or from kthreadd (PID 2, always in the
+	// initial namespaces) when PID 1's links are not readable
+	initNS := make(map[string]uint64)
+	kthreaddNS := make(map[string]uint64)
+
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		info.Processes++
+
+		readable := false
+		for _, nsType := range NamespaceTypes {
+			path := filepath.Join(procRoot, entry.Name(), "ns", nsType)
+			inode, ok := parseNamespaceLink(path, nsType)
+			if !ok {
+				continue
+			}
+			readable = true
+			switch pid {
+			case 1:
+				initNS[nsType] = inode
+			case 2:
+				kthreaddNS[nsType] = inode
+			}
+
+			k := key{nsType, inode}
+			ns, exists := byKey[k]
+			if !exists {
+				ns = &models.Namespace{Type: nsType, Inode: inode, ExamplePID: pid}
+				byKey[k] = ns
+				examplePath[k] = path
+			}
+			ns.Processes++
+			if pid < ns.ExamplePID {
+				ns.ExamplePID = pid
+				examplePath[k] = path
+			}
+		}
+		if !readable {
+			info.Unreadable++
+		}
+	}
+
+	if len(initNS) == 0 && readOptional(filepath.Join(procRoot, "2", "comm")) == "kthreadd" {
+		initNS = kthreaddNS
+	}
+
+	// PID 1's mnt and net namespaces are the root ones only when PID 1 itself
+	// runs in the initial PID namespace, i.e. we are not inside a container
+	hostView := initNS["pid"] == initNamespaceInodes["pid"]
+
+	for k, ns := range byKey {
+		if fixed, ok := initNamespaceInodes[k.nsType]; ok {
+			ns.Root = ns.Inode == fixed
+		} else {
+			ns.Root = hostView && ns.Inode == initNS[k.nsType]
+		}
+		ns.Command = readOptional(filepath.Join(procRoot, strconv.Itoa(ns.ExamplePID), "comm"))
+		if owner != nil {
+			ns.OwnerUserNS = owner(examplePath[k], k.nsType)
+		}
+		info.Namespaces = append(info.Namespaces, *ns)
+		info.Counts[k.nsType]++
+	}
+
+	order := make(map[string]int)
+	for i, t := range NamespaceTypes {
+		order[t] = i
+	}
+	sort.Slice(info.Namespaces, func(i, j int) bool {
+		a, b := info.Namespaces[i], info.Namespaces[j]
+		if a.Type != b.Type {
+			return order[a.Type] < order[b.Type]
+		}
+		if a.Root != b.Root {
+			return a.Root
+		}
+		if a.Processes != b.Processes {
+			return a.Processes > b.Processes
+		}
+		return a.Inode < b.Inode
+	})
+
+	return info
+}
+
+// parseNamespaceLink reads a link such as "net:[4026531840]"; reading another
+// user's links needs ptrace access, so failures are common without root
+func parseNamespaceLink(path, nsType string) (uint64, bool) {
+	target, err := os.Readlink(path)
+	if err != nil {
+		return 0, false
+	}
+	value, ok := strings.CutPrefix(target, nsType+":[")
+	if !ok {
+		return 0, false
+	}
+	inode, err := strconv.ParseUint(strings.TrimSuffix(value, "]"), 10, 64)
+	return inode, err == nil
+}
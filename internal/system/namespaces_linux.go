@@ -0,0 +1,36 @@
+//go:build linux
+// +build linux
+
+package system
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// namespaceOwner returns the inode of the user namespace owning an ns file;
+// for a user namespace that is its parent
+func namespaceOwner(path, nsType string) uint64 {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0
+	}
+	defer f.Close()
+
+	req := uint(unix.NS_GET_USERNS)
+	if nsType == "user" {
+		req = unix.NS_GET_PARENT
+	}
+	fd, err := unix.IoctlRetInt(int(f.Fd()), req)
+	if err != nil {
+		return 0
+	}
+	defer unix.Close(fd)
+
+	var st unix.Stat_t
+	if err := unix.Fstat(fd, &st); err != nil {
+		return 0
+	}
+	return st.Ino
+}
@@ -0,0 +1,128 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+// writeNamespaces creates /proc/[pid]/ns links with the given inodes per type
+func writeNamespaces(t *testing.T, root string, pid int, comm string, inodes map[string]uint64) {
+	t.Helper()
+	dir := filepath.Join(root, strconv.Itoa(pid))
+	writeFile(t, filepath.Join(dir, "comm"), comm+"\n")
+	if err := os.MkdirAll(filepath.Join(dir, "ns"), 0o755); err != nil {
+		t.Fatalf("MkdirAll failed: %v", err)
+	}
+	for nsType, inode := range inodes {
+		target := nsType + ":[" + strconv.FormatUint(inode, 10) + "]"
+		if err := os.Symlink(target, filepath.Join(dir, "ns", nsType)); err != nil {
+			t.Skipf("symlinks not supported: %v", err)
+		}
+	}
+}
+
+func TestScanNamespaces(t *testing.T) {
+	root := t.TempDir()
+	host := map[string]uint64{"net": 4026531840, "pid": 4026531836, "user": 4026531837, "mnt": 4026531841}
+	container := map[string]uint64{"net": 4026532300, "pid": 4026532301, "user": 4026531837, "mnt": 4026532299}
+
+	writeNamespaces(t, root, 1, "systemd", host)
+	writeNamespaces(t, root, 812, "sshd", host)
+	writeNamespaces(t, root, 4310, "nginx", container)
+	writeNamespaces(t, root, 4302, "nginx", container)
+	writeNamespaces(t, root, 4400, "pause", map[string]uint64{"net": 4026532300, "pid": 4026532400})
+	// Another user's process without ptrace access has no readable links
+	if err := os.MkdirAll(filepath.Join(root, "5000"), 0o755); err != nil {
+		t.Fatalf("MkdirAll failed: %v", err)
+	}
+
+	owner := func(path, nsType string) uint64 {
+		if nsType == "user" {
+			return 0
+		}
+		return 4026531837
+	}
+	info := scanNamespaces(root, owner)
+
+	if info.Processes != 6 || info.Unreadable != 1 {
+		t.Errorf("Expected 6 processes with 1 unreadable, got %d/%d", info.Processes, info.Unreadable)
+	}
+	if info.Counts["net"] != 2 || info.Counts["pid"] != 3 || info.Counts["user"] != 1 {
+		t.Errorf("Unexpected counts: %v", info.Counts)
+	}
+
+	var nets []string
+	for _, ns := range info.Namespaces {
+		if ns.Type == "net" {
+			nets = append(nets, strconv.FormatUint(ns.Inode, 10))
+		}
+	}
+	if info.Namespaces[0].Type != "mnt" || len(nets) != 2 || nets[0] != "4026531840" {
+		t.Fatalf("Expected root namespaces first in type order, got %+v", info.Namespaces)
+	}
+
+	for _, ns := range info.Namespaces {
+		switch {
+		case ns.Type == "net" && ns.Inode == 4026531840:
+			if !ns.Root || ns.Processes != 2 || ns.ExamplePID != 1 || ns.Command != "systemd" {
+				t.Errorf("Unexpected host net namespace: %+v", ns)
+			}
+		case ns.Type == "net" && ns.Inode == 4026532300:
+			if ns.Root || ns.Processes != 3 || ns.ExamplePID != 4302 || ns.OwnerUserNS != 4026531837 {
+				t.Errorf("Unexpected container net namespace: %+v", ns)
+			}
+		case ns.Type == "user":
+			if !ns.Root || ns.Processes != 4 || ns.OwnerUserNS != 0 {
+				t.Errorf("Unexpected user namespace: %+v", ns)
+			}
+		}
+	}
+}
+
+func TestScanNamespacesInsideContainer(t *testing.T) {
+	root := t.TempDir()
+	// PID 1 is a container init, so its mnt/net are not the host's
+	writeNamespaces(t, root, 1, "tini", map[string]uint64{"net": 4026532300, "pid": 4026532301})
+
+	info := scanNamespaces(root, nil)
+	for _, ns := range info.Namespaces {
+		if ns.Root {
+			t.Errorf("Expected no root namespaces inside a container, got %+v", ns)
+		}
+	}
+}
+
+func TestScanNamespacesKthreaddFallback(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "1"), 0o755); err != nil {
+		t.Fatalf("MkdirAll failed: %v", err)
+	}
+	writeNamespaces(t, root, 2, "kthreadd", map[string]uint64{"net": 4026531833, "pid": 4026531836})
+	writeNamespaces(t, root, 300, "app", map[string]uint64{"net": 4026532001, "pid": 4026531836})
+
+	info := scanNamespaces(root, nil)
+	for _, ns := range info.Namespaces {
+		if ns.Type == "net" && ns.Root != (ns.Inode == 4026531833) {
+			t.Errorf("Expected only kthreadd's net namespace to be root, got %+v", ns)
+		}
+	}
+}
+
+func TestParseNamespaceLink(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.Symlink("net:[4026531840]", filepath.Join(dir, "net")); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+
+	if inode, ok := parseNamespaceLink(filepath.Join(dir, "net"), "net"); !ok || inode != 4026531840 {
+		t.Errorf("Expected 4026531840, got %d %v", inode, ok)
+	}
+	if _, ok := parseNamespaceLink(filepath.Join(dir, "net"), "pid"); ok {
+		t.Errorf("Expected type mismatch to fail")
+	}
+	if _, ok := parseNamespaceLink(filepath.Join(dir, "missing"), "net"); ok {
+		t.Errorf("Expected missing link to fail")
+	}
+}
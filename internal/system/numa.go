@@ -0,0 +1,118 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+const sysNodePath = "/sys/devices/system/node"
+
+// GetNUMAInfo returns per-node CPUs, memory, numastat counters and distances
+func GetNUMAInfo() (*models.NUMAInfo, error) {
+	return readNUMANodes(sysNodePath), nil
+}
+
+// readNUMANodes reads every nodeN directory under root
+func readNUMANodes(root string) *models.NUMAInfo {
+	info := &models.NUMAInfo{
+		Nodes: make([]models.NUMANode, 0),
+	}
+
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		info.SingleNode = true
+		info.Message = "NUMA topology not exposed by this kernel; treating as a single node"
+		return info
+	}
+
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), "node") {
+			continue
+		}
+		id, err := strconv.Atoi(strings.TrimPrefix(entry.Name(), "node"))
+		if err != nil {
+			continue
+		}
+		info.Nodes = append(info.Nodes, readNUMANode(filepath.Join(root, entry.Name()), id))
+	}
+
+	sort.Slice(info.Nodes, func(i, j int) bool {
+		return info.Nodes[i].Node < info.Nodes[j].Node
+	})
+
+	if len(info.Nodes) <= 1 {
+		info.SingleNode = true
+		info.Message = "single NUMA node"
+	}
+
+	return info
+}
+
+func readNUMANode(dir string, id int) models.NUMANode {
+	node := models.NUMANode{
+		Node:      id,
+		Distances: make([]int, 0),
+	}
+
+	if cpus, err := readSysfsString(filepath.Join(dir, "cpulist")); err == nil {
+		node.CPUs = cpus
+	}
+
+	if data, err := os.ReadFile(filepath.Join(dir, "meminfo")); err == nil {
+		mem := parseMeminfo(string(data))
+		node.MemTotalMB = float64(mem["MemTotal"]) / 1024.0
+		node.MemFreeMB = float64(mem["MemFree"]) / 1024.0
+		node.FilePagesMB = float64(mem["FilePages"]) / 1024.0
+		if node.MemTotalMB > 0 {
+			node.UsagePercent = (node.MemTotalMB - node.MemFreeMB) / node.MemTotalMB * 100
+		}
+	}
+
+	if data, err := os.ReadFile(filepath.Join(dir, "numastat")); err == nil {
+		stat := parseVMStat(string(data))
+		node.NumaHit = stat["numa_hit"]
+		node.NumaMiss = stat["numa_miss"]
+		node.NumaForeign = stat["numa_foreign"]
+		node.InterleaveHit = stat["interleave_hit"]
+		node.LocalNode = stat["local_node"]
+		node.OtherNode = stat["other_node"]
+	}
+
+	if distance, err := readSysfsString(filepath.Join(dir, "distance")); err == nil {
+		for _, field := range strings.Fields(distance) {
+			if d, err := strconv.Atoi(field); err == nil {
+				node.Distances = append(node.Distances, d)
+			}
+		}
+	}
+
+	return node
+}
+
+// parseMeminfo parses /proc/meminfo or per-node meminfo content into kB values
+// Per-node lines carry a "Node N" prefix: "Node 0 MemTotal:  4816632 kB"
+func parseMeminfo(data string) map[string]uint64 {
+	values := make(map[string]uint64)
+
+	for _, line := range strings.Split(data, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 && fields[0] == "Node" {
+			fields = fields[2:]
+		}
+		if len(fields) < 2 {
+			continue
+		}
+
+		key := strings.TrimSuffix(fields[0], ":")
+		if v, err := strconv.ParseUint(fields[1], 10, 64); err == nil {
+			values[key] = v
+		}
+	}
+
+	return values
+}
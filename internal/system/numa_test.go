@@ -0,0 +1,76 @@
+package system
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func writeNUMANode(t *testing.T, root, name, cpus, distance string, totalKB, freeKB string) {
+	t.Helper()
+	dir := filepath.Join(root, name)
+	id := name[len("node"):]
+	writeFile(t, filepath.Join(dir, "cpulist"), cpus+"\n")
+	writeFile(t, filepath.Join(dir, "distance"), distance+"\n")
+	writeFile(t, filepath.Join(dir, "meminfo"), "Node "+id+" MemTotal:       "+totalKB+" kB\n"+
+		"Node "+id+" MemFree:        "+freeKB+" kB\n"+
+		"Node "+id+" FilePages:      1048576 kB\n")
+	writeFile(t, filepath.Join(dir, "numastat"), "numa_hit 1000\nnuma_miss 20\nnuma_foreign 30\n"+
+		"interleave_hit 5\nlocal_node 990\nother_node 10\n")
+}
+
+func TestReadNUMANodes(t *testing.T) {
+	root := t.TempDir()
+	writeNUMANode(t, root, "node0", "0-7,16-23", "10 21", "4194304", "1048576")
+	writeNUMANode(t, root, "node1", "8-15,24-31", "21 10", "4194304", "3145728")
+	writeFile(t, filepath.Join(root, "online"), "0-1\n")
+
+	info := readNUMANodes(root)
+	if info.SingleNode {
+		t.Errorf("Expected multiple nodes")
+	}
+	if len(info.Nodes) != 2 {
+		t.Fatalf("Expected 2 nodes, got %d", len(info.Nodes))
+	}
+
+	n0 := info.Nodes[0]
+	if n0.Node != 0 || n0.CPUs != "0-7,16-23" {
+		t.Errorf("Unexpected node0: %+v", n0)
+	}
+	if n0.MemTotalMB != 4096 || n0.MemFreeMB != 1024 || n0.FilePagesMB != 1024 {
+		t.Errorf("Unexpected node0 memory: %+v", n0)
+	}
+	if n0.UsagePercent != 75 {
+		t.Errorf("Expected 75%% usage, got %.2f", n0.UsagePercent)
+	}
+	if n0.NumaMiss != 20 || n0.NumaForeign != 30 || n0.OtherNode != 10 {
+		t.Errorf("Unexpected numastat: %+v", n0)
+	}
+	if !reflect.DeepEqual(info.Nodes[1].Distances, []int{21, 10}) {
+		t.Errorf("Unexpected node1 distances: %v", info.Nodes[1].Distances)
+	}
+}
+
+func TestReadNUMANodesSingle(t *testing.T) {
+	root := t.TempDir()
+	writeNUMANode(t, root, "node0", "0-3", "10", "1024", "512")
+
+	info := readNUMANodes(root)
+	if !info.SingleNode || len(info.Nodes) != 1 {
+		t.Errorf("Expected single node, got %+v", info)
+	}
+
+	missing := readNUMANodes(filepath.Join(root, "missing"))
+	if !missing.SingleNode || len(missing.Nodes) != 0 {
+		t.Errorf("Expected single node without topology, got %+v", missing)
+	}
+}
+
+func TestParseMeminfo(t *testing.T) {
+	data := "MemTotal:       16314372 kB\nHugePages_Total:       4\nNode 1 MemFree:  2048 kB\n"
+
+	mem := parseMeminfo(data)
+	if mem["MemTotal"] != 16314372 || mem["HugePages_Total"] != 4 || mem["MemFree"] != 2048 {
+		t.Errorf("Unexpected meminfo values: %v", mem)
+	}
+}
@@ -0,0 +1,169 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+const procPressurePath = "/proc/pressure"
+
+// pressureResources are the PSI files exposed by the kernel
+var pressureResources = []string{"cpu", "memory", "io"}
+
+// GetPressureInfo returns Pressure Stall Information for the system and
+// for each top-level cgroup. When prev is set (watch mode), the stall time
+// accumulated since prev is reported as a percentage of wall time.
+func GetPressureInfo(prev *models.PressureInfo) (*models.PressureInfo, error) {
+	info := &models.PressureInfo{
+		System:     make([]models.PressureResource, 0),
+		Cgroups:    make([]models.CgroupPressure, 0),
+		SampleTime: time.Now(),
+	}
+
+	resources, err := readPressureDir(procPressurePath, "")
+	if err != nil {
+		info.Message = "pressure stall information is not available (requires Linux 4.20+ with CONFIG_PSI enabled)"
+		return info, nil
+	}
+	info.Available = true
+	info.System = resources
+
+	root := cgroup2Root()
+	entries, err := os.ReadDir(root)
+	if err == nil {
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+			resources, err := readPressureDir(filepath.Join(root, entry.Name()), ".pressure")
+			if err != nil {
+				continue
+			}
+			info.Cgroups = append(info.Cgroups, models.CgroupPressure{
+				Path:      "/" + entry.Name(),
+				Resources: resources,
+			})
+		}
+		sort.Slice(info.Cgroups, func(i, j int) bool {
+			return info.Cgroups[i].Path < info.Cgroups[j].Path
+		})
+	}
+
+	if prev != nil && prev.Available {
+		applyPressureRates(info, prev)
+	}
+
+	return info, nil
+}
+
+// readPressureDir reads <dir>/<resource><suffix> for every PSI resource
+// Files that are missing (e.g. a controller not enabled) are skipped
+func readPressureDir(dir, suffix string) ([]models.PressureResource, error) {
+	resources := make([]models.PressureResource, 0, len(pressureResources))
+
+	var lastErr error
+	for _, name := range pressureResources {
+		data, err := os.ReadFile(filepath.Join(dir, name+suffix))
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		some, full := parsePressure(string(data))
+		resources = append(resources, models.PressureResource{
+			Resource: name,
+			Some:     some,
+			Full:     full,
+		})
+	}
+
+	if len(resources) == 0 {
+		return nil, lastErr
+	}
+	return resources, nil
+}
+
+// parsePressure parses the contents of a PSI file
+// Format: some avg10=0.00 avg60=0.00 avg300=0.00 total=0
+func parsePressure(data string) (some, full models.PressureStat) {
+	for _, line := range strings.Split(data, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		var stat models.PressureStat
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				continue
+			}
+			switch key {
+			case "avg10":
+				stat.Avg10, _ = strconv.ParseFloat(value, 64)
+			case "avg60":
+				stat.Avg60, _ = strconv.ParseFloat(value, 64)
+			case "avg300":
+				stat.Avg300, _ = strconv.ParseFloat(value, 64)
+			case "total":
+				stat.TotalUs, _ = strconv.ParseUint(value, 10, 64)
+			}
+		}
+
+		switch fields[0] {
+		case "some":
+			some = stat
+		case "full":
+			full = stat
+		}
+	}
+
+	return some, full
+}
+
+// applyPressureRates fills RatePercent from the change in total stall time
+func applyPressureRates(cur, prev *models.PressureInfo) {
+	elapsedUs := float64(cur.SampleTime.Sub(prev.SampleTime).Microseconds())
+	if elapsedUs <= 0 {
+		return
+	}
+
+	applyResourceRates(cur.System, prev.System, elapsedUs)
+
+	prevCgroups := make(map[string][]models.PressureResource, len(prev.Cgroups))
+	for _, cg := range prev.Cgroups {
+		prevCgroups[cg.Path] = cg.Resources
+	}
+	for _, cg := range cur.Cgroups {
+		if resources, ok := prevCgroups[cg.Path]; ok {
+			applyResourceRates(cg.Resources, resources, elapsedUs)
+		}
+	}
+}
+
+func applyResourceRates(cur, prev []models.PressureResource, elapsedUs float64) {
+	for i := range cur {
+		for _, p := range prev {
+			if p.Resource != cur[i].Resource {
+				continue
+			}
+			cur[i].Some.RatePercent = stallRate(cur[i].Some.TotalUs, p.Some.TotalUs, elapsedUs)
+			cur[i].Full.RatePercent = stallRate(cur[i].Full.TotalUs, p.Full.TotalUs, elapsedUs)
+		}
+	}
+}
+
+// stallRate returns the share of elapsed time spent stalled, in percent
+func stallRate(cur, prev uint64, elapsedUs float64) *float64 {
+	if cur < prev {
+		return nil
+	}
+	rate := float64(cur-prev) / elapsedUs * 100
+	return &rate
+}
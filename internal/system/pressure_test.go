@@ -0,0 +1,86 @@
+package system
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+func TestParsePressure(t *testing.T) {
+	data := `some avg10=1.18 avg60=1.79 avg300=1.98 total=16402360
+full avg10=0.50 avg60=0.25 avg300=0.10 total=1443703
+`
+
+	some, full := parsePressure(data)
+	if some.Avg10 != 1.18 || some.Avg60 != 1.79 || some.Avg300 != 1.98 {
+		t.Errorf("Unexpected some averages: %+v", some)
+	}
+	if some.TotalUs != 16402360 {
+		t.Errorf("Expected some total 16402360, got %d", some.TotalUs)
+	}
+	if full.Avg10 != 0.50 || full.TotalUs != 1443703 {
+		t.Errorf("Unexpected full line: %+v", full)
+	}
+}
+
+func TestParsePressureSomeOnly(t *testing.T) {
+	// Kernels before 5.13 only report "some" for cpu
+	some, full := parsePressure("some avg10=0.00 avg60=0.00 avg300=0.00 total=42\n")
+	if some.TotalUs != 42 || full.TotalUs != 0 {
+		t.Errorf("Unexpected result: some=%+v full=%+v", some, full)
+	}
+}
+
+func TestReadPressureDir(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "cpu.pressure"), "some avg10=2.00 avg60=1.00 avg300=0.50 total=100\n")
+	writeFile(t, filepath.Join(root, "io.pressure"), "some avg10=0.00 avg60=0.00 avg300=0.00 total=0\nfull avg10=0.00 avg60=0.00 avg300=0.00 total=0\n")
+
+	resources, err := readPressureDir(root, ".pressure")
+	if err != nil {
+		t.Fatalf("readPressureDir failed: %v", err)
+	}
+	if len(resources) != 2 || resources[0].Resource != "cpu" || resources[1].Resource != "io" {
+		t.Errorf("Unexpected resources: %+v", resources)
+	}
+
+	if _, err := readPressureDir(filepath.Join(root, "missing"), ""); err == nil {
+		t.Errorf("Expected error when no PSI files exist")
+	}
+}
+
+func TestApplyPressureRates(t *testing.T) {
+	start := time.Now()
+	prev := &models.PressureInfo{
+		Available:  true,
+		SampleTime: start,
+		System:     []models.PressureResource{{Resource: "memory", Some: models.PressureStat{TotalUs: 1000000}}},
+		Cgroups: []models.CgroupPressure{{
+			Path:      "/system.slice",
+			Resources: []models.PressureResource{{Resource: "io", Full: models.PressureStat{TotalUs: 0}}},
+		}},
+	}
+	cur := &models.PressureInfo{
+		Available:  true,
+		SampleTime: start.Add(2 * time.Second),
+		System:     []models.PressureResource{{Resource: "memory", Some: models.PressureStat{TotalUs: 1500000}}},
+		Cgroups: []models.CgroupPressure{{
+			Path:      "/system.slice",
+			Resources: []models.PressureResource{{Resource: "io", Full: models.PressureStat{TotalUs: 200000}}},
+		}},
+	}
+
+	applyPressureRates(cur, prev)
+
+	rate := cur.System[0].Some.RatePercent
+	if rate == nil || *rate != 25.0 {
+		t.Errorf("Expected 25%% memory stall rate, got %v", rate)
+	}
+
+	rate = cur.Cgroups[0].Resources[0].Full.RatePercent
+	if rate == nil || *rate != 10.0 {
+		t.Errorf("Expected 10%% cgroup io stall rate, got %v", rate)
+	}
+}
@@ -0,0 +1,31 @@
+package system
+
+import (
+	"time"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+// counterRate returns the per-second change of a cumulative counter
+// A counter that went backwards (reset or wrap) yields no rate
+func counterRate(cur, prev uint64, elapsed time.Duration) *float64 {
+	if cur < prev || elapsed <= 0 {
+		return nil
+	}
+	rate := float64(cur-prev) / elapsed.Seconds()
+	return &rate
+}
+
+// applyCounterRates fills PerSecond on cur from counters of the same name in prev
+func applyCounterRates(cur, prev []models.CounterRate, elapsed time.Duration) {
+	previous := make(map[string]uint64, len(prev))
+	for _, c := range prev {
+		previous[c.Name] = c.Total
+	}
+
+	for i := range cur {
+		if total, ok := previous[cur[i].Name]; ok {
+			cur[i].PerSecond = counterRate(cur[i].Total, total, elapsed)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+// hwmonKinds lists the hwmon channel types reported, with the divisor that
+// converts the sysfs unit (millidegree, millivolt, microwatt) to the display unit
+var hwmonKinds = []struct {
+	prefix  string
+	unit    string
+	divisor float64
+}{
+	{"temp", "°C", 1000},
+	{"fan", "RPM", 1},
+	{"in", "V", 1000},
+	{"power", "W", 1000000},
+}
+
+// GetSensorsInfo reads thermal zones, hwmon sensors and CPU thermal throttle
+// counters; machines without sensors get an empty result
+func GetSensorsInfo() (*models.SensorsInfo, error) {
+	return readSensors("/sys/class/thermal", "/sys/class/hwmon", "/sys/devices/system/cpu"), nil
+}
+
+func readSensors(thermalDir, hwmonDir, cpuDir string) *models.SensorsInfo {
+	info := &models.SensorsInfo{
+		ThermalZones: readThermalZones(thermalDir),
+		Hwmon:        readHwmonChips(hwmonDir),
+	}
+
+	// Throttle counters let temperature samples be lined up with throttling;
+	// every CPU repeats its package's count, so count each package once
+	packages := make(map[string]bool)
+	cpus, _ := filepath.Glob(filepath.Join(cpuDir, "cpu[0-9]*", "thermal_throttle"))
+	for _, dir := range cpus {
+		if v, err := readSysfsUint(filepath.Join(dir, "core_throttle_count")); err == nil {
+			info.CoreThrottleCount += v
+		}
+		pkg := readOptional(filepath.Join(dir, "..", "topology", "physical_package_id"))
+		if packages[pkg] {
+			continue
+		}
+		if v, err := readSysfsUint(filepath.Join(dir, "package_throttle_count")); err == nil {
+			info.PackageThrottleCount += v
+			packages[pkg] = true
+		}
+	}
+
+	return info
+}
+
+// readThermalZones reads thermal_zone*/{type,temp,trip_point_*}
+func readThermalZones(dir string) []models.ThermalZone {
+	zones := make([]models.ThermalZone, 0)
+	paths, _ := filepath.Glob(filepath.Join(dir, "thermal_zone*"))
+	sortByNumericSuffix(paths, "thermal_zone")
+
+	for _, path := range paths {
+		zone := models.ThermalZone{
+			Name:  filepath.Base(path),
+			Type:  readOptional(filepath.Join(path, "type")),
+			Trips: make([]models.TripPoint, 0),
+		}
+		// Reading temp fails for zones whose sensor is powered down
+		if v, err := readMilli(filepath.Join(path, "temp"), 1000); err == nil {
+			zone.TempC = &v
+		}
+
+		for i := 0; ; i++ {
+			prefix := filepath.Join(path, "trip_point_"+strconv.Itoa(i)+"_")
+			kind, err := readSysfsString(prefix + "type")
+			if err != nil {
+				break
+			}
+			temp, err := readMilli(prefix+"temp", 1000)
+			if err != nil {
+				continue
+			}
+			zone.Trips = append(zone.Trips, models.TripPoint{Type: kind, TempC: temp})
+		}
+
+		zones = append(zones, zone)
+	}
+
+	return zones
+}
+
+// readHwmonChips reads hwmon*/ channels; older drivers keep them under device/
+func readHwmonChips(dir string) []models.HwmonChip {
+	chips := make([]models.HwmonChip, 0)
+	paths, _ := filepath.Glob(filepath.Join(dir, "hwmon*"))
+	sortByNumericSuffix(paths, "hwmon")
+
+	for _, path := range paths {
+		attrDir := path
+		if _, err := os.Stat(filepath.Join(path, "name")); err != nil {
+			attrDir = filepath.Join(path, "device")
+		}
+
+		chip := models.HwmonChip{
+			Hwmon: filepath.Base(path),
+			Name:  readOptional(filepath.Join(attrDir, "name")),
+		}
+		if target, err := os.Readlink(filepath.Join(path, "device")); err == nil {
+			chip.Device = filepath.Base(target)
+		}
+		chip.Sensors = readHwmonSensors(attrDir)
+		chips = append(chips, chip)
+	}
+
+	return chips
+}
+
+// readHwmonSensors groups <kind><N>_<attr> files into one reading per channel
+func readHwmonSensors(dir string) []models.SensorReading {
+	sensors := make([]models.SensorReading, 0)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return sensors
+	}
+
+	channels := make(map[string]bool)
+	for _, entry := range entries {
+		channel, _, ok := strings.Cut(entry.Name(), "_")
+		if ok && channelKind(channel) >= 0 {
+			channels[channel] = true
+		}
+	}
+
+	for channel := range channels {
+		kind := hwmonKinds[channelKind(channel)]
+		attr := func(name string) string { return filepath.Join(dir, channel+"_"+name) }
+
+		value, err := readMilli(attr("input"), kind.divisor)
+		if err != nil && kind.prefix == "power" {
+			value, err = readMilli(attr("average"), kind.divisor)
+		}
+		if err != nil {
+			continue
+		}
+
+		reading := models.SensorReading{
+			Sensor: channel,
+			Type:   kind.prefix,
+			Label:  readOptional(attr("label")),
+			Value:  value,
+			Unit:   kind.unit,
+		}
+		for name, dst := range map[string]**float64{"min": &reading.Min, "max": &reading.Max, "crit": &reading.Crit} {
+			if v, err := readMilli(attr(name), kind.divisor); err == nil {
+				*dst = &v
+			}
+		}
+		sensors = append(sensors, reading)
+	}
+
+	sort.Slice(sensors, func(i, j int) bool {
+		ki, kj := channelKind(sensors[i].Sensor), channelKind(sensors[j].Sensor)
+		if ki != kj {
+			return ki < kj
+		}
+		return channelIndex(sensors[i].Sensor) < channelIndex(sensors[j].Sensor)
+	})
+	return sensors
+}
+
+// channelKind returns the hwmonKinds index for a channel such as "temp1", or -1
+func channelKind(channel string) int {
+	for i, kind := range hwmonKinds {
+		if rest, ok := strings.CutPrefix(channel, kind.prefix); ok && rest != "" {
+			if _, err := strconv.Atoi(rest); err == nil {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func channelIndex(channel string) int {
+	n, _ := strconv.Atoi(strings.TrimLeft(channel, "abcdefghijklmnopqrstuvwxyz"))
+	return n
+}
+
+// readMilli reads an integer sysfs value and divides it into display units
+func readMilli(path string, divisor float64) (float64, error) {
+	value, err := readSysfsString(path)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return float64(n) / divisor, nil
+}
+
+// sortByNumericSuffix orders paths like hwmon2, hwmon10 numerically
+func sortByNumericSuffix(paths []string, prefix string) {
+	sort.Slice(paths, func(i, j int) bool {
+		a, _ := strconv.Atoi(strings.TrimPrefix(filepath.Base(paths[i]), prefix))
+		b, _ := strconv.Atoi(strings.TrimPrefix(filepath.Base(paths[j]), prefix))
+		return a < b
+	})
+}
@@ -0,0 +1,113 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadSensors(t *testing.T) {
+	root := t.TempDir()
+	thermal := filepath.Join(root, "thermal")
+	hwmon := filepath.Join(root, "hwmon")
+	cpu := filepath.Join(root, "cpu")
+
+	writeFile(t, filepath.Join(thermal, "thermal_zone0/type"), "acpitz\n")
+	writeFile(t, filepath.Join(thermal, "thermal_zone0/temp"), "27800\n")
+	writeFile(t, filepath.Join(thermal, "thermal_zone0/trip_point_0_type"), "critical\n")
+	writeFile(t, filepath.Join(thermal, "thermal_zone0/trip_point_0_temp"), "119000\n")
+	writeFile(t, filepath.Join(thermal, "thermal_zone10/type"), "x86_pkg_temp\n")
+	writeFile(t, filepath.Join(thermal, "thermal_zone10/temp"), "45000\n")
+	writeFile(t, filepath.Join(thermal, "thermal_zone10/trip_point_0_type"), "passive\n")
+	writeFile(t, filepath.Join(thermal, "thermal_zone10/trip_point_0_temp"), "95000\n")
+	writeFile(t, filepath.Join(thermal, "thermal_zone10/trip_point_1_type"), "critical\n")
+	writeFile(t, filepath.Join(thermal, "thermal_zone10/trip_point_1_temp"), "105000\n")
+	writeFile(t, filepath.Join(thermal, "thermal_zone2/type"), "iwlwifi_1\n")
+
+	writeFile(t, filepath.Join(hwmon, "hwmon1/name"), "coretemp\n")
+	writeFile(t, filepath.Join(hwmon, "hwmon1/temp1_input"), "46000\n")
+	writeFile(t, filepath.Join(hwmon, "hwmon1/temp1_label"), "Package id 0\n")
+	writeFile(t, filepath.Join(hwmon, "hwmon1/temp1_max"), "80000\n")
+	writeFile(t, filepath.Join(hwmon, "hwmon1/temp1_crit"), "100000\n")
+	writeFile(t, filepath.Join(hwmon, "hwmon1/temp10_input"), "44000\n")
+	writeFile(t, filepath.Join(hwmon, "hwmon1/temp2_input"), "43000\n")
+	writeFile(t, filepath.Join(hwmon, "hwmon1/temp2_crit_alarm"), "0\n")
+	writeFile(t, filepath.Join(hwmon, "hwmon1/uevent"), "\n")
+
+	// Older drivers keep attributes below device/
+	writeFile(t, filepath.Join(hwmon, "hwmon2/device/name"), "nct6775\n")
+	writeFile(t, filepath.Join(hwmon, "hwmon2/device/fan1_input"), "1250\n")
+	writeFile(t, filepath.Join(hwmon, "hwmon2/device/fan1_min"), "300\n")
+	writeFile(t, filepath.Join(hwmon, "hwmon2/device/in0_input"), "1112\n")
+	writeFile(t, filepath.Join(hwmon, "hwmon2/device/in0_label"), "Vcore\n")
+	writeFile(t, filepath.Join(hwmon, "hwmon2/device/power1_average"), "12500000\n")
+	writeFile(t, filepath.Join(hwmon, "hwmon2/device/fan2_min"), "300\n")
+
+	for i, pkg := range []string{"0", "0", "1"} {
+		dir := filepath.Join(cpu, "cpu"+string(rune('0'+i)))
+		writeFile(t, filepath.Join(dir, "thermal_throttle/core_throttle_count"), "3\n")
+		writeFile(t, filepath.Join(dir, "thermal_throttle/package_throttle_count"), "7\n")
+		writeFile(t, filepath.Join(dir, "topology/physical_package_id"), pkg+"\n")
+	}
+
+	info := readSensors(thermal, hwmon, cpu)
+
+	if len(info.ThermalZones) != 3 {
+		t.Fatalf("Expected 3 thermal zones, got %d", len(info.ThermalZones))
+	}
+	zone := info.ThermalZones[2]
+	if zone.Name != "thermal_zone10" || zone.Type != "x86_pkg_temp" || zone.TempC == nil || *zone.TempC != 45 {
+		t.Errorf("Unexpected zone ordering or temperature: %+v", zone)
+	}
+	if len(zone.Trips) != 2 || zone.Trips[0].Type != "passive" || zone.Trips[1].TempC != 105 {
+		t.Errorf("Unexpected trip points: %+v", zone.Trips)
+	}
+	if info.ThermalZones[1].TempC != nil {
+		t.Errorf("Expected no temperature for an unreadable zone")
+	}
+
+	if len(info.Hwmon) != 2 {
+		t.Fatalf("Expected 2 hwmon chips, got %d", len(info.Hwmon))
+	}
+	core := info.Hwmon[0]
+	if core.Name != "coretemp" || len(core.Sensors) != 3 {
+		t.Fatalf("Unexpected coretemp chip: %+v", core)
+	}
+	if s := core.Sensors[0]; s.Sensor != "temp1" || s.Label != "Package id 0" || s.Value != 46 || s.Unit != "°C" || *s.Max != 80 || *s.Crit != 100 || s.Min != nil {
+		t.Errorf("Unexpected temp1: %+v", s)
+	}
+	if core.Sensors[2].Sensor != "temp10" {
+		t.Errorf("Expected numeric channel ordering, got %+v", core.Sensors)
+	}
+
+	board := info.Hwmon[1]
+	if board.Name != "nct6775" || len(board.Sensors) != 3 {
+		t.Fatalf("Unexpected nct6775 chip: %+v", board)
+	}
+	fan, in, power := board.Sensors[0], board.Sensors[1], board.Sensors[2]
+	if fan.Sensor != "fan1" || fan.Value != 1250 || fan.Unit != "RPM" || *fan.Min != 300 {
+		t.Errorf("Unexpected fan: %+v", fan)
+	}
+	if in.Label != "Vcore" || in.Value != 1.112 || in.Unit != "V" {
+		t.Errorf("Unexpected voltage: %+v", in)
+	}
+	if power.Sensor != "power1" || power.Value != 12.5 || power.Unit != "W" {
+		t.Errorf("Unexpected power: %+v", power)
+	}
+
+	if info.CoreThrottleCount != 9 || info.PackageThrottleCount != 14 {
+		t.Errorf("Expected throttle counts 9/14, got %d/%d", info.CoreThrottleCount, info.PackageThrottleCount)
+	}
+}
+
+func TestReadSensorsAbsent(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "thermal"), 0o755); err != nil {
+		t.Fatalf("MkdirAll failed: %v", err)
+	}
+
+	info := readSensors(filepath.Join(root, "thermal"), filepath.Join(root, "hwmon"), filepath.Join(root, "cpu"))
+	if info.ThermalZones == nil || info.Hwmon == nil || len(info.ThermalZones) != 0 || len(info.Hwmon) != 0 {
+		t.Errorf("Expected empty non-nil results, got %+v", info)
+	}
+}
@@ -0,0 +1,73 @@
+package system
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+const procStatPath = "/proc/stat"
+
+// GetKernelStatInfo returns context switch, interrupt, softirq and fork
+// counters plus the number of running and blocked processes
+// When prev is set (watch mode), per-second rates since prev are included
+func GetKernelStatInfo(prev *models.KernelStatInfo) (*models.KernelStatInfo, error) {
+	data, err := os.ReadFile(procStatPath)
+	if err != nil {
+		return nil, err
+	}
+
+	stat := parseProcStat(string(data))
+	info := &models.KernelStatInfo{
+		Counters: []models.CounterRate{
+			{Name: "context_switches", Total: firstValue(stat["ctxt"])},
+			{Name: "interrupts", Total: firstValue(stat["intr"])},
+			{Name: "soft_interrupts", Total: firstValue(stat["softirq"])},
+			{Name: "processes_forked", Total: firstValue(stat["processes"])},
+		},
+		ProcsRunning: firstValue(stat["procs_running"]),
+		ProcsBlocked: firstValue(stat["procs_blocked"]),
+		SampleTime:   time.Now(),
+	}
+
+	if prev != nil {
+		applyCounterRates(info.Counters, prev.Counters, info.SampleTime.Sub(prev.SampleTime))
+	}
+
+	return info, nil
+}
+
+// parseProcStat maps each /proc/stat line label to its numeric fields
+// e.g. "intr 1234 0 5 ..." -> "intr": [1234 0 5 ...]
+func parseProcStat(data string) map[string][]uint64 {
+	stat := make(map[string][]uint64)
+
+	for _, line := range strings.Split(data, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		values := make([]uint64, 0, len(fields)-1)
+		for _, field := range fields[1:] {
+			v, err := strconv.ParseUint(field, 10, 64)
+			if err != nil {
+				break
+			}
+			values = append(values, v)
+		}
+		stat[fields[0]] = values
+	}
+
+	return stat
+}
+
+func firstValue(values []uint64) uint64 {
+	if len(values) == 0 {
+		return 0
+	}
+	return values[0]
+}
@@ -0,0 +1,48 @@
+package system
+
+import (
+	"testing"
+)
+
+func TestParseProcStat(t *testing.T) {
+	data := `cpu  10132153 290696 3084719 46828483 16683 0 25195 0 0 0
+cpu0 1393280 32966 572056 13343292 6130 0 17875 0 0 0
+intr 1462898 43 9 0 0 0 0 0 0 1 0
+ctxt 115315
+btime 1700000000
+processes 6152
+procs_running 3
+procs_blocked 1
+softirq 3521 0 1234 2 0 0 0 5 0 0 2280
+`
+
+	stat := parseProcStat(data)
+
+	tests := []struct {
+		key      string
+		expected uint64
+	}{
+		{"ctxt", 115315},
+		{"intr", 1462898},
+		{"softirq", 3521},
+		{"processes", 6152},
+		{"procs_running", 3},
+		{"procs_blocked", 1},
+		{"btime", 1700000000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if got := firstValue(stat[tt.key]); got != tt.expected {
+				t.Errorf("%s = %d, want %d", tt.key, got, tt.expected)
+			}
+		})
+	}
+
+	if len(stat["intr"]) != 11 {
+		t.Errorf("Expected 11 intr values, got %d", len(stat["intr"]))
+	}
+	if firstValue(stat["missing"]) != 0 {
+		t.Errorf("Expected 0 for missing key")
+	}
+}
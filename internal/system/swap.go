@@ -0,0 +1,186 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+const (
+	procSwapsPath   = "/proc/swaps"
+	sysBlockPath    = "/sys/block"
+	zswapParamsPath = "/sys/module/zswap/parameters"
+)
+
+// GetSwapInfo returns per-device swap usage plus zram and zswap details
+// Only Linux exposes these sources; other platforms return an empty result
+func GetSwapInfo() (*models.SwapInfo, error) {
+	info := &models.SwapInfo{
+		Devices: make([]models.SwapDevice, 0),
+		Zram:    make([]models.ZramDevice, 0),
+	}
+
+	if data, err := os.ReadFile(procSwapsPath); err == nil {
+		info.Devices = parseProcSwaps(string(data))
+	}
+
+	info.Zram = readZramDevices(sysBlockPath)
+	info.Zswap = readZswap(zswapParamsPath)
+
+	return info, nil
+}
+
+// parseProcSwaps parses /proc/swaps content (sizes are reported in KB)
+// Format: Filename Type Size Used Priority
+func parseProcSwaps(data string) []models.SwapDevice {
+	devices := make([]models.SwapDevice, 0)
+
+	lines := strings.Split(data, "\n")
+	for i, line := range lines {
+		if i == 0 {
+			// Skip header
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 5 {
+			continue
+		}
+
+		sizeKB, err := strconv.ParseUint(fields[2], 10, 64)
+		if err != nil {
+			continue
+		}
+		usedKB, _ := strconv.ParseUint(fields[3], 10, 64)
+		priority, _ := strconv.Atoi(fields[4])
+
+		devices = append(devices, models.SwapDevice{
+			Filename: unescapeOctal(fields[0]),
+			Type:     fields[1],
+			SizeMB:   float64(sizeKB) / 1024.0,
+			UsedMB:   float64(usedKB) / 1024.0,
+			Priority: priority,
+		})
+	}
+
+	return devices
+}
+
+// readZramDevices collects statistics for every zram device under sysBlockDir
+func readZramDevices(sysBlockDir string) []models.ZramDevice {
+	devices := make([]models.ZramDevice, 0)
+
+	matches, err := filepath.Glob(filepath.Join(sysBlockDir, "zram*"))
+	if err != nil {
+		return devices
+	}
+	sort.Strings(matches)
+
+	for _, dir := range matches {
+		// Unconfigured devices have a zero disksize and no useful stats
+		diskSize, err := readSysfsUint(filepath.Join(dir, "disksize"))
+		if err != nil || diskSize == 0 {
+			continue
+		}
+
+		device := models.ZramDevice{
+			Name:       filepath.Base(dir),
+			DiskSizeMB: bytesToMB(diskSize),
+		}
+
+		if algo, err := readSysfsString(filepath.Join(dir, "comp_algorithm")); err == nil {
+			device.Algorithm = parseSelectedOption(algo)
+		}
+
+		if mmStat, err := readSysfsString(filepath.Join(dir, "mm_stat")); err == nil {
+			orig, compr, memUsed := parseZramMMStat(mmStat)
+			device.OriginalMB = bytesToMB(orig)
+			device.CompressedMB = bytesToMB(compr)
+			device.MemUsedMB = bytesToMB(memUsed)
+			if compr > 0 {
+				device.CompressionRatio = float64(orig) / float64(compr)
+			}
+		}
+
+		devices = append(devices, device)
+	}
+
+	return devices
+}
+
+// parseZramMMStat extracts original, compressed and total memory used bytes
+// Format: orig_data_size compr_data_size mem_used_total mem_limit mem_used_max ...
+func parseZramMMStat(data string) (orig, compr, memUsed uint64) {
+	fields := strings.Fields(data)
+	if len(fields) < 3 {
+		return 0, 0, 0
+	}
+
+	orig, _ = strconv.ParseUint(fields[0], 10, 64)
+	compr, _ = strconv.ParseUint(fields[1], 10, 64)
+	memUsed, _ = strconv.ParseUint(fields[2], 10, 64)
+	return orig, compr, memUsed
+}
+
+// readZswap reads the zswap module parameters from paramsDir
+func readZswap(paramsDir string) models.ZswapInfo {
+	info := models.ZswapInfo{
+		Parameters: make(map[string]string),
+	}
+
+	entries, err := os.ReadDir(paramsDir)
+	if err != nil {
+		return info
+	}
+	info.Available = true
+
+	for _, entry := range entries {
+		value, err := readSysfsString(filepath.Join(paramsDir, entry.Name()))
+		if err != nil {
+			continue
+		}
+		if entry.Name() == "enabled" {
+			info.Enabled = value == "Y" || value == "1"
+			continue
+		}
+		info.Parameters[entry.Name()] = value
+	}
+
+	return info
+}
+
+// parseSelectedOption returns the bracketed choice from a sysfs option list
+// e.g. "lzo lzo-rle [lz4] zstd" -> "lz4"
+func parseSelectedOption(options string) string {
+	start := strings.Index(options, "[")
+	end := strings.Index(options, "]")
+	if start >= 0 && end > start {
+		return options[start+1 : end]
+	}
+	return strings.TrimSpace(options)
+}
+
+// unescapeOctal decodes the \040-style escapes the kernel uses for
+// whitespace in paths reported by /proc files
+func unescapeOctal(s string) string {
+	if !strings.Contains(s, "\\") {
+		return s
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+4 <= len(s) {
+			if v, err := strconv.ParseUint(s[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(v))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
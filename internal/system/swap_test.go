@@ -0,0 +1,88 @@
+package system
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestParseProcSwaps(t *testing.T) {
+	data := `Filename				Type		Size		Used		Priority
+/dev/zram0                              partition	4194300		1048576		100
+/swap\040file                           file		2097148		0		-2
+`
+
+	devices := parseProcSwaps(data)
+	if len(devices) != 2 {
+		t.Fatalf("parseProcSwaps got %d devices, want 2", len(devices))
+	}
+
+	if devices[0].Filename != "/dev/zram0" || devices[0].Type != "partition" {
+		t.Errorf("Unexpected first device: %+v", devices[0])
+	}
+	if devices[0].UsedMB != 1024.0 {
+		t.Errorf("Expected used 1024 MB, got %.2f", devices[0].UsedMB)
+	}
+	if devices[0].Priority != 100 {
+		t.Errorf("Expected priority 100, got %d", devices[0].Priority)
+	}
+
+	if devices[1].Filename != "/swap file" {
+		t.Errorf("Expected escaped filename to be decoded, got %q", devices[1].Filename)
+	}
+	if devices[1].Priority != -2 {
+		t.Errorf("Expected priority -2, got %d", devices[1].Priority)
+	}
+}
+
+func TestParseProcSwapsEmpty(t *testing.T) {
+	devices := parseProcSwaps("Filename\tType\tSize\tUsed\tPriority\n")
+	if len(devices) != 0 {
+		t.Errorf("Expected no devices, got %d", len(devices))
+	}
+}
+
+func TestReadZramDevices(t *testing.T) {
+	root := t.TempDir()
+
+	writeFile(t, filepath.Join(root, "zram0", "disksize"), "4294967296\n")
+	writeFile(t, filepath.Join(root, "zram0", "comp_algorithm"), "lzo lzo-rle [zstd]\n")
+	writeFile(t, filepath.Join(root, "zram0", "mm_stat"), "1073741824 268435456 285212672 0 285212672 0 0 0 0\n")
+	// Unconfigured device should be skipped
+	writeFile(t, filepath.Join(root, "zram1", "disksize"), "0\n")
+
+	devices := readZramDevices(root)
+	if len(devices) != 1 {
+		t.Fatalf("readZramDevices got %d devices, want 1", len(devices))
+	}
+
+	d := devices[0]
+	if d.Name != "zram0" || d.Algorithm != "zstd" {
+		t.Errorf("Unexpected device: %+v", d)
+	}
+	if d.OriginalMB != 1024.0 || d.CompressedMB != 256.0 {
+		t.Errorf("Expected 1024/256 MB, got %.2f/%.2f", d.OriginalMB, d.CompressedMB)
+	}
+	if d.CompressionRatio != 4.0 {
+		t.Errorf("Expected compression ratio 4.0, got %.2f", d.CompressionRatio)
+	}
+}
+
+func TestReadZswap(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "enabled"), "Y\n")
+	writeFile(t, filepath.Join(root, "compressor"), "lz4\n")
+	writeFile(t, filepath.Join(root, "max_pool_percent"), "20\n")
+
+	info := readZswap(root)
+	if !info.Available || !info.Enabled {
+		t.Errorf("Expected zswap available and enabled, got %+v", info)
+	}
+	if info.Parameters["compressor"] != "lz4" {
+		t.Errorf("Expected compressor lz4, got %q", info.Parameters["compressor"])
+	}
+
+	missing := readZswap(filepath.Join(root, "missing"))
+	if missing.Available {
+		t.Errorf("Expected zswap unavailable for missing directory")
+	}
+}
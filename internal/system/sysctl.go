@@ -0,0 +1,295 @@
+package system
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+const procSysPath = "/proc/sys"
+
+// volatileSysctls are writable keys the kernel changes on its own, so a
+// saved value says nothing about drift
+var volatileSysctls = map[string]bool{
+	"kernel.ns_last_pid":                true,
+	"kernel.perf_event_max_sample_rate": true,
+}
+
+// GetSysctlInfo returns kernel parameters under /proc/sys in dotted sysctl
+// notation, limited to prefixes when given. When compareFile is set, the
+// desired values it lists are checked against the live ones instead.
+func GetSysctlInfo(prefixes []string, compareFile string) (*models.SysctlInfo, error) {
+	info := &models.SysctlInfo{
+		Unreadable: make([]models.SysctlError, 0),
+		Mismatches: make([]models.SysctlMismatch, 0),
+	}
+	for _, p := range prefixes {
+		info.Prefixes = append(info.Prefixes, normalizeSysctlKey(p))
+	}
+
+	if compareFile == "" {
+		info.Entries, info.Unreadable = readSysctls(procSysPath, info.Prefixes)
+		return info, nil
+	}
+
+	desired, err := LoadSysctlFile(compareFile)
+	if err != nil {
+		return nil, err
+	}
+	info.ComparedWith = compareFile
+	info.Mismatches, info.Unreadable, info.Checked = compareSysctls(procSysPath, desired, info.Prefixes)
+
+	return info, nil
+}
+
+// readSysctls walks root and reads every key matching prefixes
+func readSysctls(root string, prefixes []string) ([]models.SysctlEntry, []models.SysctlError) {
+	entries := make([]models.SysctlEntry, 0)
+	unreadable := make([]models.SysctlError, 0)
+
+	filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		rel, relErr := filepath.Rel(root, path)
+		if relErr != nil || rel == "." {
+			return nil
+		}
+		key := sysctlKey(rel)
+
+		if err != nil {
+			unreadable = append(unreadable, models.SysctlError{Key: key, Error: sysctlError(err)})
+			if d != nil && d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+
+		if d.IsDir() {
+			if !sysctlDirMatches(key, prefixes) {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if !sysctlKeyMatches(key, prefixes) {
+			return nil
+		}
+
+		value, err := readSysctlValue(path)
+		if err != nil {
+			unreadable = append(unreadable, models.SysctlError{Key: key, Error: sysctlError(err)})
+			return nil
+		}
+		entries = append(entries, models.SysctlEntry{Key: key, Value: value})
+		return nil
+	})
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Key < entries[j].Key })
+	sort.Slice(unreadable, func(i, j int) bool { return unreadable[i].Key < unreadable[j].Key })
+
+	return entries, unreadable
+}
+
+// compareSysctls reads each desired key and returns those whose live value differs.
+// Read-only status files and volatile keys are not tunables and are skipped.
+func compareSysctls(root string, desired []models.SysctlEntry, prefixes []string) ([]models.SysctlMismatch, []models.SysctlError, int) {
+	mismatches := make([]models.SysctlMismatch, 0)
+	unreadable := make([]models.SysctlError, 0)
+	checked := 0
+
+	for _, want := range desired {
+		path := filepath.Join(root, sysctlPath(want.Key))
+		if !sysctlKeyMatches(want.Key, prefixes) || !sysctlTunable(path, want.Key) {
+			continue
+		}
+		checked++
+
+		actual, err := readSysctlValue(path)
+		switch {
+		case errors.Is(err, fs.ErrNotExist) && want.IgnoreMissing:
+			continue
+		case errors.Is(err, fs.ErrNotExist):
+			mismatches = append(mismatches, models.SysctlMismatch{
+				Key:      want.Key,
+				Expected: want.Value,
+				Missing:  true,
+			})
+		case err != nil:
+			unreadable = append(unreadable, models.SysctlError{Key: want.Key, Error: sysctlError(err)})
+		case actual != normalizeSysctlValue(want.Value):
+			mismatches = append(mismatches, models.SysctlMismatch{
+				Key:      want.Key,
+				Expected: want.Value,
+				Actual:   actual,
+			})
+		}
+	}
+
+	return mismatches, unreadable, checked
+}
+
+// LoadSysctlFile reads desired values from a sysctl.conf-style file
+// ("key = value", # or ; comments) or a saved `sysinfo sysctl --format json`
+func LoadSysctlFile(path string) ([]models.SysctlEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.HasPrefix(strings.TrimSpace(string(data)), "{") {
+		var snapshot models.SysctlInfo
+		if err := json.Unmarshal(data, &snapshot); err != nil {
+			return nil, fmt.Errorf("invalid sysctl snapshot %s: %v", path, err)
+		}
+		return snapshot.Entries, nil
+	}
+
+	return parseSysctlConf(string(data))
+}
+
+// parseSysctlConf parses sysctl.conf content; later assignments win
+func parseSysctlConf(data string) ([]models.SysctlEntry, error) {
+	entries := make([]models.SysctlEntry, 0)
+	index := make(map[string]int)
+
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", lineNo)
+		}
+		// A leading "-" tells sysctl to ignore errors for the key
+		key = strings.TrimSpace(key)
+		ignoreMissing := strings.HasPrefix(key, "-")
+		key = normalizeSysctlKey(strings.TrimPrefix(key, "-"))
+		value = normalizeSysctlValue(value)
+		if key == "" {
+			return nil, fmt.Errorf("line %d: empty key", lineNo)
+		}
+
+		if i, ok := index[key]; ok {
+			entries[i].Value = value
+			entries[i].IgnoreMissing = ignoreMissing
+			continue
+		}
+		index[key] = len(entries)
+		entries = append(entries, models.SysctlEntry{Key: key, Value: value, IgnoreMissing: ignoreMissing})
+	}
+
+	return entries, scanner.Err()
+}
+
+// sysctlTunable reports whether key can be set, i.e. it is writable and not
+// rewritten by the kernel. Missing keys count as tunable so they are reported.
+func sysctlTunable(path, key string) bool {
+	if volatileSysctls[key] {
+		return false
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		return true
+	}
+	return fi.Mode().Perm()&0222 != 0
+}
+
+// readSysctlValue reads a key file, refusing write-only entries such as vm.drop_caches
+func readSysctlValue(path string) (string, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	if fi.Mode().Perm()&0444 == 0 {
+		return "", errors.New("write-only")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return normalizeSysctlValue(string(data)), nil
+}
+
+// normalizeSysctlValue collapses the tabs used by multi-value keys into single spaces
+func normalizeSysctlValue(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
+
+// sysctlKey converts a path relative to /proc/sys into dotted notation
+// Dots inside a component (e.g. VLAN interface eth0.100) become slashes
+func sysctlKey(rel string) string {
+	return swapSeparators(filepath.ToSlash(rel))
+}
+
+// sysctlPath converts a dotted key back into a path relative to /proc/sys
+func sysctlPath(key string) string {
+	return filepath.FromSlash(swapSeparators(key))
+}
+
+// normalizeSysctlKey accepts both net.ipv4.ip_forward and net/ipv4/ip_forward
+// Like sysctl(8), the first separator decides which form is in use
+func normalizeSysctlKey(key string) string {
+	key = strings.Trim(strings.TrimSpace(key), "./")
+	if i := strings.IndexAny(key, "./"); i >= 0 && key[i] == '/' {
+		return swapSeparators(key)
+	}
+	return key
+}
+
+func swapSeparators(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '.':
+			return '/'
+		case '/':
+			return '.'
+		}
+		return r
+	}, s)
+}
+
+// sysctlKeyMatches reports whether key is one of or below one of prefixes
+func sysctlKeyMatches(key string, prefixes []string) bool {
+	if len(prefixes) == 0 {
+		return true
+	}
+	for _, p := range prefixes {
+		if key == p || strings.HasPrefix(key, p+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// sysctlDirMatches reports whether a directory may contain keys matching prefixes
+func sysctlDirMatches(key string, prefixes []string) bool {
+	if sysctlKeyMatches(key, prefixes) {
+		return true
+	}
+	for _, p := range prefixes {
+		if strings.HasPrefix(p, key+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// sysctlError strips the path from an error, which the key already identifies
+func sysctlError(err error) string {
+	var pathErr *fs.PathError
+	if errors.As(err, &pathErr) {
+		return pathErr.Err.Error()
+	}
+	return err.Error()
+}
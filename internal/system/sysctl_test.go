@@ -0,0 +1,190 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func sysctlFixture(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "net/ipv4/ip_forward"), "1\n")
+	writeFile(t, filepath.Join(root, "net/ipv4/tcp_rmem"), "4096\t131072\t6291456\n")
+	writeFile(t, filepath.Join(root, "net/ipv4/conf/eth0.100/rp_filter"), "2\n")
+	writeFile(t, filepath.Join(root, "vm/swappiness"), "60\n")
+	writeFile(t, filepath.Join(root, "vm/drop_caches"), "")
+	if err := os.Chmod(filepath.Join(root, "vm/drop_caches"), 0o200); err != nil {
+		t.Fatalf("Chmod failed: %v", err)
+	}
+	writeFile(t, filepath.Join(root, "kernel/hostname"), "box\n")
+	return root
+}
+
+func TestReadSysctls(t *testing.T) {
+	root := sysctlFixture(t)
+
+	entries, unreadable := readSysctls(root, nil)
+	got := make(map[string]string)
+	for _, e := range entries {
+		got[e.Key] = e.Value
+	}
+
+	want := map[string]string{
+		"net.ipv4.ip_forward":              "1",
+		"net.ipv4.tcp_rmem":                "4096 131072 6291456",
+		"net.ipv4.conf.eth0/100.rp_filter": "2",
+		"vm.swappiness":                    "60",
+		"kernel.hostname":                  "box",
+	}
+	for key, value := range want {
+		if got[key] != value {
+			t.Errorf("%s = %q, want %q", key, got[key], value)
+		}
+	}
+	if len(entries) != len(want) {
+		t.Errorf("Expected %d entries, got %d", len(want), len(entries))
+	}
+
+	if len(unreadable) != 1 || unreadable[0].Key != "vm.drop_caches" || unreadable[0].Error != "write-only" {
+		t.Errorf("Expected vm.drop_caches reported as write-only, got %+v", unreadable)
+	}
+}
+
+func TestReadSysctlsPrefixes(t *testing.T) {
+	root := sysctlFixture(t)
+
+	entries, _ := readSysctls(root, []string{"net.ipv4.conf", "kernel"})
+	if len(entries) != 2 || entries[0].Key != "kernel.hostname" || entries[1].Key != "net.ipv4.conf.eth0/100.rp_filter" {
+		t.Errorf("Unexpected prefix-filtered entries %+v", entries)
+	}
+
+	// A prefix must match whole components, not partial names
+	entries, _ = readSysctls(root, []string{"vm.swap"})
+	if len(entries) != 0 {
+		t.Errorf("Expected no entries for partial component prefix, got %+v", entries)
+	}
+}
+
+func TestParseSysctlConf(t *testing.T) {
+	conf := `# tuning
+; legacy comment
+net.ipv4.ip_forward = 1
+net/ipv4/tcp_rmem = 4096	87380   6291456
+-vm.swappiness=10
+vm.swappiness = 20
+`
+	entries, err := parseSysctlConf(conf)
+	if err != nil {
+		t.Fatalf("parseSysctlConf failed: %v", err)
+	}
+
+	want := []struct{ key, value string }{
+		{"net.ipv4.ip_forward", "1"},
+		{"net.ipv4.tcp_rmem", "4096 87380 6291456"},
+		{"vm.swappiness", "20"},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("Expected %d entries, got %+v", len(want), entries)
+	}
+	for i, w := range want {
+		if entries[i].Key != w.key || entries[i].Value != w.value {
+			t.Errorf("Entry %d = %+v, want %s=%s", i, entries[i], w.key, w.value)
+		}
+	}
+
+	if entries[2].IgnoreMissing {
+		t.Errorf("Expected the later plain assignment to clear ignore-missing")
+	}
+	if entries, _ := parseSysctlConf("-kernel.foo = 1\n"); len(entries) != 1 || !entries[0].IgnoreMissing || entries[0].Key != "kernel.foo" {
+		t.Errorf("Expected -kernel.foo to be marked ignore-missing, got %+v", entries)
+	}
+
+	if _, err := parseSysctlConf("not an assignment\n"); err == nil {
+		t.Errorf("Expected error for line without '='")
+	}
+}
+
+func TestCompareSysctls(t *testing.T) {
+	root := sysctlFixture(t)
+	desired, err := parseSysctlConf(`
+net.ipv4.ip_forward = 1
+net.ipv4.tcp_rmem = 4096 87380 6291456
+vm.swappiness = 10
+vm.drop_caches = 3
+net.ipv4.tcp_bogus = 1
+`)
+	if err != nil {
+		t.Fatalf("parseSysctlConf failed: %v", err)
+	}
+
+	mismatches, unreadable, checked := compareSysctls(root, desired, nil)
+	if checked != 5 {
+		t.Errorf("Expected 5 keys checked, got %d", checked)
+	}
+	if len(unreadable) != 1 || unreadable[0].Key != "vm.drop_caches" {
+		t.Errorf("Expected vm.drop_caches unreadable, got %+v", unreadable)
+	}
+
+	got := make(map[string]bool)
+	for _, m := range mismatches {
+		got[m.Key] = m.Missing
+	}
+	if len(mismatches) != 3 {
+		t.Errorf("Expected 3 mismatches, got %+v", mismatches)
+	}
+	if missing, ok := got["net.ipv4.tcp_rmem"]; !ok || missing {
+		t.Errorf("Expected tcp_rmem value mismatch, got %+v", mismatches)
+	}
+	if missing := got["net.ipv4.tcp_bogus"]; !missing {
+		t.Errorf("Expected tcp_bogus reported missing, got %+v", mismatches)
+	}
+
+	// "-key" lines may name keys this kernel lacks without failing the check
+	optional, _ := parseSysctlConf("-net.ipv4.tcp_bogus = 1\n-net.ipv4.ip_forward = 0\n")
+	mismatches, _, _ = compareSysctls(root, optional, nil)
+	if len(mismatches) != 1 || mismatches[0].Key != "net.ipv4.ip_forward" {
+		t.Errorf("Expected only the existing -key value mismatch, got %+v", mismatches)
+	}
+
+	_, _, checked = compareSysctls(root, desired, []string{"vm"})
+	if checked != 2 {
+		t.Errorf("Expected 2 keys checked under vm, got %d", checked)
+	}
+}
+
+func TestCompareSysctlsSnapshotSkipsStatusKeys(t *testing.T) {
+	root := sysctlFixture(t)
+	writeFile(t, filepath.Join(root, "kernel/random/uuid"), "0b7c3f4e-1f0a-4d8e-9c55-6a1d2e3f4a5b\n")
+	writeFile(t, filepath.Join(root, "fs/dentry-state"), "81234\t60123\t45\t0\t1201\t0\n")
+	writeFile(t, filepath.Join(root, "kernel/ns_last_pid"), "4242\n")
+	for _, rel := range []string{"kernel/random/uuid", "fs/dentry-state"} {
+		if err := os.Chmod(filepath.Join(root, rel), 0o444); err != nil {
+			t.Fatalf("Chmod failed: %v", err)
+		}
+	}
+
+	snapshot, _ := readSysctls(root, nil)
+
+	// Status files and counters change between runs without anyone tuning them
+	for rel, value := range map[string]string{
+		"kernel/random/uuid": "9f1e2d3c-4b5a-4968-8776-5a4b3c2d1e0f\n",
+		"fs/dentry-state":    "81502\t60377\t45\t0\t1188\t0\n",
+		"kernel/ns_last_pid": "4310\n",
+	} {
+		path := filepath.Join(root, rel)
+		os.Chmod(path, 0o644)
+		writeFile(t, path, value)
+		if rel != "kernel/ns_last_pid" {
+			os.Chmod(path, 0o444)
+		}
+	}
+
+	mismatches, _, checked := compareSysctls(root, snapshot, nil)
+	if len(mismatches) != 0 {
+		t.Errorf("Expected a snapshot to match itself, got %+v", mismatches)
+	}
+	if checked != 5 {
+		t.Errorf("Expected only the 5 tunable keys checked, got %d", checked)
+	}
+}
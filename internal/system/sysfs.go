@@ -0,0 +1,25 @@
+package system
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// readSysfsString reads a single-value sysfs/procfs file and trims whitespace
+func readSysfsString(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// readSysfsUint reads a single unsigned integer from a sysfs/procfs file
+func readSysfsUint(path string) (uint64, error) {
+	value, err := readSysfsString(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(value, 10, 64)
+}
@@ -0,0 +1,37 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadSysfsUint(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "value"), "4096\n")
+	writeFile(t, filepath.Join(root, "bad"), "not-a-number\n")
+
+	value, err := readSysfsUint(filepath.Join(root, "value"))
+	if err != nil || value != 4096 {
+		t.Errorf("readSysfsUint = %d, %v; want 4096, nil", value, err)
+	}
+
+	if _, err := readSysfsUint(filepath.Join(root, "bad")); err == nil {
+		t.Errorf("Expected error for non-numeric value")
+	}
+
+	if _, err := readSysfsUint(filepath.Join(root, "missing")); err == nil {
+		t.Errorf("Expected error for missing file")
+	}
+}
+
+// writeFile creates a fixture file, including parent directories
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("MkdirAll failed: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+}
@@ -0,0 +1,167 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+const (
+	etcLocaltimePath = "/etc/localtime"
+	etcTimezonePath  = "/etc/timezone"
+	sysClocksource   = "/sys/devices/system/clocksource/clocksource0"
+	sysRTCPath       = "/sys/class/rtc/rtc0"
+)
+
+// Kernel clock states returned by adjtimex
+var clockStates = map[int]string{
+	0: "ok",
+	1: "insert leap second",
+	2: "delete leap second",
+	3: "leap second in progress",
+	4: "leap second occurred",
+	5: "error (clock not synchronized)",
+}
+
+// adjtimex status bits (STA_*), in bit order
+var clockStatusBits = []struct {
+	bit  int32
+	name string
+}{
+	{0x0001, "PLL"},
+	{0x0002, "PPSFREQ"},
+	{0x0004, "PPSTIME"},
+	{0x0008, "FLL"},
+	{0x0010, "INS"},
+	{0x0020, "DEL"},
+	{0x0040, "UNSYNC"},
+	{0x0080, "FREQHOLD"},
+	{0x0100, "PPSSIGNAL"},
+	{0x0200, "PPSJITTER"},
+	{0x0400, "PPSWANDER"},
+	{0x0800, "PPSERROR"},
+	{0x1000, "CLOCKERR"},
+	{0x2000, "NANO"},
+	{0x4000, "MODE"},
+	{0x8000, "CLK"},
+}
+
+const (
+	clockStateError   = 5
+	clockStatusUnsync = 0x0040
+	clockStatusNano   = 0x2000
+)
+
+// GetTimeInfo returns timezone, UTC/local time, kernel NTP discipline
+// status, clocksource and the RTC time compared to system time
+func GetTimeInfo() (*models.TimeInfo, error) {
+	now := time.Now()
+	abbrev, offset := now.Zone()
+
+	info := &models.TimeInfo{
+		TZ:         os.Getenv("TZ"),
+		ZoneAbbrev: abbrev,
+		UTCOffset:  formatUTCOffset(offset),
+		UTC:        now.UTC().Format(time.RFC3339Nano),
+		Local:      now.Format(time.RFC3339Nano),
+		NTP:        readNTPStatus(),
+	}
+	info.Timezone, info.TimezoneSource = readTimezone(info.TZ, etcLocaltimePath, etcTimezonePath)
+
+	info.Clocksource, _ = readSysfsString(filepath.Join(sysClocksource, "current_clocksource"))
+	available, _ := readSysfsString(filepath.Join(sysClocksource, "available_clocksource"))
+	info.AvailableClocksources = strings.Fields(available)
+
+	info.RTC = readRTC(sysRTCPath, now)
+
+	return info, nil
+}
+
+// readTimezone resolves the zone name from TZ, the /etc/localtime symlink
+// target or /etc/timezone, in that order
+func readTimezone(tz, localtimePath, timezonePath string) (string, string) {
+	if tz != "" {
+		return strings.TrimPrefix(tz, ":"), "TZ"
+	}
+
+	if target, err := os.Readlink(localtimePath); err == nil {
+		if i := strings.Index(target, "zoneinfo/"); i >= 0 {
+			return target[i+len("zoneinfo/"):], localtimePath
+		}
+		return target, localtimePath
+	}
+
+	if name, err := readSysfsString(timezonePath); err == nil && name != "" {
+		return name, timezonePath
+	}
+
+	if _, err := os.Stat(localtimePath); err == nil {
+		return "unknown (copied zoneinfo file)", localtimePath
+	}
+	return "UTC", "default"
+}
+
+// readRTC reads the first hardware clock and its skew from system time
+func readRTC(dir string, now time.Time) models.RTCStatus {
+	rtc := models.RTCStatus{}
+
+	epoch, err := readSysfsUint(filepath.Join(dir, "since_epoch"))
+	if err != nil {
+		return rtc
+	}
+
+	rtc.Available = true
+	rtc.Name, _ = readSysfsString(filepath.Join(dir, "name"))
+	rtc.Time = time.Unix(int64(epoch), 0).UTC().Format(time.RFC3339)
+	// since_epoch has one second resolution, so small skews read as 0 or -1
+	rtc.SkewSeconds = int64(epoch) - now.Unix()
+
+	return rtc
+}
+
+// newNTPStatus converts raw adjtimex results into reportable units
+// Offset is in microseconds, or nanoseconds when STA_NANO is set;
+// frequency is in ppm scaled by 2^16; error estimates are in microseconds
+func newNTPStatus(state int, status int32, offset, freq, maxError, estError, constant int64, tai int32) models.NTPStatus {
+	ntp := models.NTPStatus{
+		Available:    true,
+		Synchronized: state != clockStateError && status&clockStatusUnsync == 0,
+		State:        clockStates[state],
+		StatusFlags:  make([]string, 0),
+		FrequencyPPM: float64(freq) / 65536,
+		MaxErrorMs:   float64(maxError) / 1000,
+		EstErrorMs:   float64(estError) / 1000,
+		TimeConstant: constant,
+		TAIOffset:    tai,
+	}
+
+	if status&clockStatusNano != 0 {
+		ntp.OffsetMs = float64(offset) / 1e6
+	} else {
+		ntp.OffsetMs = float64(offset) / 1e3
+	}
+
+	if ntp.State == "" {
+		ntp.State = "unknown"
+	}
+	for _, s := range clockStatusBits {
+		if status&s.bit != 0 {
+			ntp.StatusFlags = append(ntp.StatusFlags, s.name)
+		}
+	}
+
+	return ntp
+}
+
+// formatUTCOffset renders a zone offset in seconds as +hh:mm
+func formatUTCOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+	return sign + time.Unix(int64(seconds), 0).UTC().Format("15:04")
+}
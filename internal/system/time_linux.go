@@ -0,0 +1,21 @@
+//go:build linux
+// +build linux
+
+package system
+
+import (
+	"github.com/example/sysinfo-cli/internal/models"
+	"golang.org/x/sys/unix"
+)
+
+// readNTPStatus queries the kernel clock discipline with a read-only adjtimex
+func readNTPStatus() models.NTPStatus {
+	var tx unix.Timex
+	state, err := unix.Adjtimex(&tx)
+	if err != nil {
+		return models.NTPStatus{Message: "adjtimex failed: " + err.Error()}
+	}
+
+	return newNTPStatus(state, tx.Status, int64(tx.Offset), int64(tx.Freq),
+		int64(tx.Maxerror), int64(tx.Esterror), int64(tx.Constant), tx.Tai)
+}
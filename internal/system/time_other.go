@@ -0,0 +1,11 @@
+//go:build !linux
+// +build !linux
+
+package system
+
+import "github.com/example/sysinfo-cli/internal/models"
+
+// readNTPStatus is only implemented on Linux, where adjtimex is available
+func readNTPStatus() models.NTPStatus {
+	return models.NTPStatus{Message: "kernel NTP status requires Linux adjtimex"}
+}
@@ -0,0 +1,85 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestReadTimezone(t *testing.T) {
+	dir := t.TempDir()
+	localtime := filepath.Join(dir, "localtime")
+	timezone := filepath.Join(dir, "timezone")
+
+	if name, source := readTimezone(":Europe/Berlin", localtime, timezone); name != "Europe/Berlin" || source != "TZ" {
+		t.Errorf("Expected TZ to win, got %s from %s", name, source)
+	}
+
+	if name, source := readTimezone("", localtime, timezone); name != "UTC" || source != "default" {
+		t.Errorf("Expected UTC default, got %s from %s", name, source)
+	}
+
+	writeFile(t, timezone, "America/Chicago\n")
+	if name, _ := readTimezone("", localtime, timezone); name != "America/Chicago" {
+		t.Errorf("Expected /etc/timezone fallback, got %s", name)
+	}
+
+	if err := os.Symlink("/usr/share/zoneinfo/Asia/Tokyo", localtime); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+	if name, source := readTimezone("", localtime, timezone); name != "Asia/Tokyo" || source != localtime {
+		t.Errorf("Expected zone from symlink, got %s from %s", name, source)
+	}
+}
+
+func TestReadRTC(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Unix(1767225600, 0)
+
+	if rtc := readRTC(dir, now); rtc.Available {
+		t.Errorf("Expected RTC unavailable without sysfs files")
+	}
+
+	writeFile(t, filepath.Join(dir, "name"), "rtc_cmos\n")
+	writeFile(t, filepath.Join(dir, "since_epoch"), "1767225642\n")
+
+	rtc := readRTC(dir, now)
+	if !rtc.Available || rtc.Name != "rtc_cmos" || rtc.SkewSeconds != 42 || rtc.Time != "2026-01-01T00:00:42Z" {
+		t.Errorf("Unexpected RTC status %+v", rtc)
+	}
+}
+
+func TestNewNTPStatus(t *testing.T) {
+	// Synchronized with PLL, offset 1500us, +12.5 ppm
+	ntp := newNTPStatus(0, 0x0001, 1500, 12*65536+32768, 16000, 2000, 7, 37)
+	if !ntp.Synchronized || ntp.State != "ok" {
+		t.Errorf("Expected synchronized ok clock, got %+v", ntp)
+	}
+	if ntp.OffsetMs != 1.5 || ntp.FrequencyPPM != 12.5 || ntp.MaxErrorMs != 16 || ntp.EstErrorMs != 2 {
+		t.Errorf("Unexpected conversions %+v", ntp)
+	}
+	if !reflect.DeepEqual(ntp.StatusFlags, []string{"PLL"}) {
+		t.Errorf("Unexpected status flags %v", ntp.StatusFlags)
+	}
+
+	// STA_NANO switches the offset to nanoseconds
+	if ntp := newNTPStatus(0, 0x2001, 1500000, 0, 0, 0, 0, 0); ntp.OffsetMs != 1.5 {
+		t.Errorf("Expected nanosecond offset of 1.5ms, got %.4f", ntp.OffsetMs)
+	}
+
+	ntp = newNTPStatus(5, 0x0040, 0, 0, 16000000, 16000000, 2, 0)
+	if ntp.Synchronized || ntp.State != "error (clock not synchronized)" {
+		t.Errorf("Expected unsynchronized clock, got %+v", ntp)
+	}
+}
+
+func TestFormatUTCOffset(t *testing.T) {
+	tests := map[int]string{0: "+00:00", 19800: "+05:30", -18000: "-05:00"}
+	for seconds, want := range tests {
+		if got := formatUTCOffset(seconds); got != want {
+			t.Errorf("formatUTCOffset(%d) = %s, want %s", seconds, got, want)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package system
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+// Execution environments reported by GetVirtInfo
+const (
+	EnvironmentBareMetal = "bare-metal"
+	EnvironmentVM        = "vm"
+	EnvironmentContainer = "container"
+)
+
+// dmiHypervisors maps substrings of DMI vendor/product strings to hypervisors
+// Order matters: QEMU machines often also carry a more specific vendor.
+// guestProduct is set for vendors that also build physical machines: without
+// the cpuinfo hypervisor flag, product_name must equal it to count as a VM.
+var dmiHypervisors = []struct {
+	match        string
+	hypervisor   string
+	guestProduct string
+}{
+	{"VMware", "vmware", ""},
+	{"VirtualBox", "virtualbox", ""},
+	{"innotek", "virtualbox", ""},
+	{"Microsoft Corporation", "hyper-v", "Virtual Machine"},
+	{"Xen", "xen", ""},
+	{"Parallels", "parallels", ""},
+	{"BHYVE", "bhyve", ""},
+	{"Amazon EC2", "kvm", ""},
+	{"Google Compute Engine", "kvm", ""},
+	{"OpenStack", "kvm", ""},
+	{"KVM", "kvm", ""},
+	{"QEMU", "qemu", ""},
+	{"Bochs", "bochs", ""},
+}
+
+// dmiFields are the /sys/class/dmi/id files checked for hypervisor strings
+var dmiFields = []string{"sys_vendor", "product_name", "bios_vendor", "board_vendor"}
+
+// GetVirtInfo reports whether sysinfo runs on bare metal, in a VM or in a
+// container, along with the evidence used to decide
+func GetVirtInfo() (*models.VirtInfo, error) {
+	return detectVirt("/", os.Getenv), nil
+}
+
+// detectVirt inspects a filesystem rooted at root; getenv supplies this
+// process's environment
+func detectVirt(root string, getenv func(string) string) *models.VirtInfo {
+	info := &models.VirtInfo{
+		Environment: EnvironmentBareMetal,
+		Evidence:    make([]string, 0),
+	}
+	evidence := func(s string) { info.Evidence = append(info.Evidence, s) }
+
+	detectHypervisor(root, info, evidence)
+	detectContainer(root, getenv, info, evidence)
+
+	// WSL kernels carry "microsoft" in their release; WSL2 runs under Hyper-V
+	if release, err := readSysfsString(filepath.Join(root, "proc/sys/kernel/osrelease")); err == nil {
+		lower := strings.ToLower(release)
+		if strings.Contains(lower, "microsoft") {
+			info.WSL = "1"
+			if strings.Contains(lower, "wsl2") || strings.Contains(lower, "microsoft-standard") {
+				info.WSL = "2"
+				if info.Hypervisor == "" || info.Hypervisor == "firecracker" {
+					info.Hypervisor = "hyper-v"
+				}
+				info.VirtualMachine = true
+			}
+			evidence("kernel release " + release + " is a WSL kernel")
+		}
+	}
+
+	// A chroot's / differs from the root of PID 1
+	rootFI, err1 := os.Stat(root)
+	initFI, err2 := os.Stat(filepath.Join(root, "proc/1/root"))
+	if err1 == nil && err2 == nil && !os.SameFile(rootFI, initFI) {
+		info.Chroot = true
+		evidence("/ differs from /proc/1/root")
+	}
+
+	switch {
+	case info.Container != "":
+		info.Environment = EnvironmentContainer
+	case info.VirtualMachine:
+		info.Environment = EnvironmentVM
+	}
+
+	return info
+}
+
+// detectHypervisor uses the cpuinfo hypervisor flag, DMI strings and /sys/hypervisor
+func detectHypervisor(root string, info *models.VirtInfo, evidence func(string)) {
+	if xen, err := readSysfsString(filepath.Join(root, "sys/hypervisor/type")); err == nil && xen != "" {
+		info.VirtualMachine = true
+		info.Hypervisor = xen
+		evidence("/sys/hypervisor/type is " + xen)
+		return
+	}
+
+	// arm64 guests have no cpuinfo hypervisor flag, so a VM-specific DMI
+	// string is enough on its own
+	flag := hasHypervisorFlag(filepath.Join(root, "proc/cpuinfo"))
+	if flag {
+		info.VirtualMachine = true
+		evidence("cpuinfo has the hypervisor flag")
+	}
+
+	dmiDir := filepath.Join(root, "sys/class/dmi/id")
+	// EC2 *.metal instances report "Amazon EC2" but run on bare metal
+	product, _ := readSysfsString(filepath.Join(dmiDir, "product_name"))
+	metal := strings.HasSuffix(product, ".metal")
+
+	dmiFound := false
+	for _, field := range dmiFields {
+		value, err := readSysfsString(filepath.Join(dmiDir, field))
+		if err != nil || value == "" {
+			continue
+		}
+		dmiFound = true
+		for _, h := range dmiHypervisors {
+			if !strings.Contains(value, h.match) || (metal && h.match == "Amazon EC2") {
+				continue
+			}
+			if !flag && h.guestProduct != "" && product != h.guestProduct {
+				continue
+			}
+			info.VirtualMachine = true
+			info.Hypervisor = h.hypervisor
+			evidence("DMI " + field + " is " + value)
+			// libvirt, Proxmox and qemu -enable-kvm guests report QEMU in DMI
+			if h.hypervisor == "qemu" && hasKVMClock(root) {
+				info.Hypervisor = "kvm"
+				evidence("kvm-clock clocksource is available")
+			}
+			return
+		}
+	}
+
+	if !flag {
+		return
+	}
+	if !dmiFound {
+		// Firecracker is the common KVM-based VMM that exposes no SMBIOS tables
+		info.Hypervisor = "firecracker"
+		evidence("no DMI tables, as with Firecracker microVMs")
+		return
+	}
+	info.Hypervisor = "unknown"
+}
+
+// hasKVMClock reports whether the paravirtual KVM clocksource is offered
+func hasKVMClock(root string) bool {
+	available, err := readSysfsString(filepath.Join(root, "sys/devices/system/clocksource/clocksource0/available_clocksource"))
+	return err == nil && containsString(strings.Fields(available), "kvm-clock")
+}
+
+// hasHypervisorFlag reports whether the CPU flags include "hypervisor"
+func hasHypervisorFlag(cpuinfoPath string) bool {
+	data, err := os.ReadFile(cpuinfoPath)
+	if err != nil {
+		return false
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok || strings.TrimSpace(key) != "flags" {
+			continue
+		}
+		return containsString(strings.Fields(value), "hypervisor")
+	}
+	return false
+}
+
+// detectContainer checks marker files, the systemd container= convention,
+// orchestrator environment variables and PID 1's cgroup, most explicit first
+func detectContainer(root string, getenv func(string) string, info *models.VirtInfo, evidence func(string)) {
+	if getenv("KUBERNETES_SERVICE_HOST") != "" {
+		info.Orchestrator = "kubernetes"
+		evidence("KUBERNETES_SERVICE_HOST is set")
+	}
+
+	if name := getenv("container"); name != "" {
+		info.Container = name
+		evidence("container=" + name + " in environment")
+		return
+	}
+	if name := pid1ContainerEnv(filepath.Join(root, "proc/1/environ")); name != "" {
+		info.Container = name
+		evidence("container=" + name + " in PID 1 environment")
+		return
+	}
+
+	if _, err := os.Stat(filepath.Join(root, "run/.containerenv")); err == nil {
+		info.Container = "podman"
+		evidence("/run/.containerenv exists")
+		return
+	}
+	if _, err := os.Stat(filepath.Join(root, ".dockerenv")); err == nil {
+		info.Container = "docker"
+		evidence("/.dockerenv exists")
+		return
+	}
+
+	if data, err := os.ReadFile(filepath.Join(root, "proc/1/cgroup")); err == nil {
+		paths := processCgroupPaths(string(data))
+		if ref, ok := findContainer(paths); ok {
+			info.Container = ref.runtime
+			if ref.podUID != "" {
+				info.Orchestrator = "kubernetes"
+			}
+			evidence("PID 1 cgroup " + ref.cgroupPath)
+			return
+		}
+		for _, path := range paths {
+			if strings.Contains(path, "/lxc/") || strings.Contains(path, "lxc.payload") {
+				info.Container = "lxc"
+				evidence("PID 1 cgroup " + path)
+				return
+			}
+		}
+	}
+
+	if info.Orchestrator == "kubernetes" {
+		info.Container = "unknown"
+	}
+}
+
+// pid1ContainerEnv returns container= from PID 1's environment (root only)
+func pid1ContainerEnv(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	for _, entry := range bytes.Split(data, []byte{0}) {
+		if value, ok := strings.CutPrefix(string(entry), "container="); ok {
+			return value
+		}
+	}
+	return ""
+}
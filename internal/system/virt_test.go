@@ -0,0 +1,181 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func noEnv(string) string { return "" }
+
+// virtFixture creates a root whose PID 1 shares it, so no chroot is reported
+func virtFixture(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "proc/1"), 0o755); err != nil {
+		t.Fatalf("MkdirAll failed: %v", err)
+	}
+	if err := os.Symlink(root, filepath.Join(root, "proc/1/root")); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+	writeFile(t, filepath.Join(root, "proc/cpuinfo"), "processor\t: 0\nflags\t\t: fpu vme sse2\n")
+	return root
+}
+
+func TestDetectVirtBareMetal(t *testing.T) {
+	root := virtFixture(t)
+
+	info := detectVirt(root, noEnv)
+	if info.Environment != EnvironmentBareMetal || info.VirtualMachine || info.Container != "" || info.Chroot {
+		t.Errorf("Expected bare metal, got %+v", info)
+	}
+}
+
+func TestDetectVirtHypervisor(t *testing.T) {
+	tests := []struct {
+		name       string
+		dmi        map[string]string
+		clocks     string
+		hypervisor string
+	}{
+		{"kvm", map[string]string{"sys_vendor": "QEMU", "product_name": "Standard PC (Q35 + ICH9, 2009)", "bios_vendor": "SeaBIOS"}, "kvm-clock tsc hpet acpi_pm", "kvm"},
+		{"qemu tcg", map[string]string{"sys_vendor": "QEMU", "product_name": "Standard PC (i440FX + PIIX, 1996)"}, "tsc hpet acpi_pm", "qemu"},
+		{"vmware", map[string]string{"sys_vendor": "VMware, Inc.", "product_name": "VMware Virtual Platform"}, "", "vmware"},
+		{"hyper-v", map[string]string{"sys_vendor": "Microsoft Corporation", "product_name": "Virtual Machine"}, "", "hyper-v"},
+		{"virtualbox", map[string]string{"sys_vendor": "innotek GmbH", "product_name": "VirtualBox"}, "", "virtualbox"},
+		{"ec2", map[string]string{"sys_vendor": "Amazon EC2", "product_name": "m5.large"}, "", "kvm"},
+		{"gce", map[string]string{"sys_vendor": "Google", "product_name": "Google Compute Engine"}, "", "kvm"},
+		{"firecracker", nil, "", "firecracker"},
+		{"unrecognized", map[string]string{"sys_vendor": "Acme"}, "", "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := virtFixture(t)
+			writeFile(t, filepath.Join(root, "proc/cpuinfo"), "flags\t\t: fpu sse2 hypervisor\n")
+			for field, value := range tt.dmi {
+				writeFile(t, filepath.Join(root, "sys/class/dmi/id", field), value+"\n")
+			}
+			if tt.clocks != "" {
+				writeFile(t, filepath.Join(root, "sys/devices/system/clocksource/clocksource0/available_clocksource"), tt.clocks+"\n")
+			}
+
+			info := detectVirt(root, noEnv)
+			if info.Environment != EnvironmentVM || info.Hypervisor != tt.hypervisor {
+				t.Errorf("Expected vm on %s, got %+v", tt.hypervisor, info)
+			}
+		})
+	}
+
+	// arm64 guests lack the cpuinfo flag; DMI alone identifies the VM
+	root := virtFixture(t)
+	writeFile(t, filepath.Join(root, "sys/class/dmi/id/sys_vendor"), "Amazon EC2\n")
+	writeFile(t, filepath.Join(root, "sys/class/dmi/id/product_name"), "c7g.large\n")
+	if info := detectVirt(root, noEnv); info.Environment != EnvironmentVM || info.Hypervisor != "kvm" {
+		t.Errorf("Expected an arm64 EC2 guest to be a kvm VM, got %+v", info)
+	}
+
+	root = virtFixture(t)
+	writeFile(t, filepath.Join(root, "sys/class/dmi/id/sys_vendor"), "Amazon EC2\n")
+	writeFile(t, filepath.Join(root, "sys/class/dmi/id/product_name"), "c7g.metal\n")
+	if info := detectVirt(root, noEnv); info.Environment != EnvironmentBareMetal || info.VirtualMachine {
+		t.Errorf("Expected an EC2 metal instance to be bare metal, got %+v", info)
+	}
+
+	// Hardware vendors that also ship hypervisors need VM-specific strings
+	for _, dmi := range []map[string]string{
+		{"sys_vendor": "Microsoft Corporation", "product_name": "Surface Laptop 5"},
+		{"sys_vendor": "Google", "product_name": "Eve"},
+	} {
+		root = virtFixture(t)
+		for field, value := range dmi {
+			writeFile(t, filepath.Join(root, "sys/class/dmi/id", field), value+"\n")
+		}
+		if info := detectVirt(root, noEnv); info.Environment != EnvironmentBareMetal || info.VirtualMachine {
+			t.Errorf("Expected %s to be bare metal, got %+v", dmi["product_name"], info)
+		}
+	}
+
+	root = virtFixture(t)
+	writeFile(t, filepath.Join(root, "sys/class/dmi/id/sys_vendor"), "Microsoft Corporation\n")
+	writeFile(t, filepath.Join(root, "sys/class/dmi/id/product_name"), "Virtual Machine\n")
+	if info := detectVirt(root, noEnv); info.Environment != EnvironmentVM || info.Hypervisor != "hyper-v" {
+		t.Errorf("Expected an arm64 Hyper-V guest to be detected, got %+v", info)
+	}
+
+	// Without the flag, missing DMI is not taken as Firecracker
+	root = virtFixture(t)
+	if info := detectVirt(root, noEnv); info.Hypervisor != "" {
+		t.Errorf("Expected no hypervisor guess without the flag, got %+v", info)
+	}
+
+	root = virtFixture(t)
+	writeFile(t, filepath.Join(root, "sys/hypervisor/type"), "xen\n")
+	if info := detectVirt(root, noEnv); info.Hypervisor != "xen" {
+		t.Errorf("Expected xen from /sys/hypervisor, got %+v", info)
+	}
+}
+
+func TestDetectVirtContainer(t *testing.T) {
+	id := strings.Repeat("ab", 32)
+
+	tests := []struct {
+		name         string
+		setup        func(root string)
+		env          map[string]string
+		container    string
+		orchestrator string
+	}{
+		{"docker", func(root string) { writeFile(t, filepath.Join(root, ".dockerenv"), "") }, nil, "docker", ""},
+		{"podman", func(root string) { writeFile(t, filepath.Join(root, "run/.containerenv"), "engine=\"podman-5.0\"\n") }, nil, "podman", ""},
+		{"nspawn env", func(string) {}, map[string]string{"container": "systemd-nspawn"}, "systemd-nspawn", ""},
+		{"pid1 environ", func(root string) {
+			writeFile(t, filepath.Join(root, "proc/1/environ"), "PATH=/bin\x00container=lxc\x00")
+		}, nil, "lxc", ""},
+		{
+			"kubernetes cgroup",
+			func(root string) {
+				writeFile(t, filepath.Join(root, "proc/1/cgroup"), "0::/kubepods/besteffort/pod0d2c6b1e-8f3a-4c5d-9e7f-1a2b3c4d5e6f/"+id+"\n")
+			},
+			map[string]string{"KUBERNETES_SERVICE_HOST": "10.0.0.1"},
+			"cri", "kubernetes",
+		},
+		{"lxc cgroup", func(root string) {
+			writeFile(t, filepath.Join(root, "proc/1/cgroup"), "0::/lxc.payload.web/init.scope\n")
+		}, nil, "lxc", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := virtFixture(t)
+			tt.setup(root)
+
+			info := detectVirt(root, func(key string) string { return tt.env[key] })
+			if info.Environment != EnvironmentContainer || info.Container != tt.container || info.Orchestrator != tt.orchestrator {
+				t.Errorf("Expected %s container (%s), got %+v", tt.container, tt.orchestrator, info)
+			}
+		})
+	}
+}
+
+func TestDetectVirtWSLAndChroot(t *testing.T) {
+	root := virtFixture(t)
+	writeFile(t, filepath.Join(root, "proc/sys/kernel/osrelease"), "5.15.153.1-microsoft-standard-WSL2\n")
+
+	info := detectVirt(root, noEnv)
+	if info.WSL != "2" || info.Hypervisor != "hyper-v" || info.Environment != EnvironmentVM {
+		t.Errorf("Expected WSL2 under hyper-v, got %+v", info)
+	}
+
+	root = t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "proc/1"), 0o755); err != nil {
+		t.Fatalf("MkdirAll failed: %v", err)
+	}
+	if err := os.Symlink(t.TempDir(), filepath.Join(root, "proc/1/root")); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+	if info := detectVirt(root, noEnv); !info.Chroot {
+		t.Errorf("Expected chroot when / differs from PID 1's root")
+	}
+}
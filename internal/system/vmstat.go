@@ -0,0 +1,88 @@
+package system
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+const procVMStatPath = "/proc/vmstat"
+
+// GetVMStatInfo returns paging, swapping, reclaim and OOM counters
+// When prev is set (watch mode), per-second rates since prev are included
+func GetVMStatInfo(prev *models.VMStatInfo) (*models.VMStatInfo, error) {
+	data, err := os.ReadFile(procVMStatPath)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &models.VMStatInfo{
+		Counters:   vmstatCounters(parseVMStat(string(data))),
+		SampleTime: time.Now(),
+	}
+
+	if prev != nil {
+		applyCounterRates(info.Counters, prev.Counters, info.SampleTime.Sub(prev.SampleTime))
+	}
+
+	return info, nil
+}
+
+// parseVMStat parses "name value" lines from /proc/vmstat
+func parseVMStat(data string) map[string]uint64 {
+	values := make(map[string]uint64)
+
+	for _, line := range strings.Split(data, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		if v, err := strconv.ParseUint(fields[1], 10, 64); err == nil {
+			values[fields[0]] = v
+		}
+	}
+
+	return values
+}
+
+// vmstatCounters derives the reported counters from raw /proc/vmstat values
+func vmstatCounters(v map[string]uint64) []models.CounterRate {
+	minor := uint64(0)
+	if v["pgfault"] > v["pgmajfault"] {
+		minor = v["pgfault"] - v["pgmajfault"]
+	}
+
+	return []models.CounterRate{
+		{Name: "page_faults_minor", Total: minor},
+		{Name: "page_faults_major", Total: v["pgmajfault"]},
+		{Name: "page_in_kb", Total: v["pgpgin"]},
+		{Name: "page_out_kb", Total: v["pgpgout"]},
+		{Name: "swap_in_pages", Total: v["pswpin"]},
+		{Name: "swap_out_pages", Total: v["pswpout"]},
+		{Name: "direct_reclaim_scanned", Total: sumVMStat(v, "pgscan_direct")},
+		{Name: "direct_reclaim_stolen", Total: sumVMStat(v, "pgsteal_direct")},
+		{Name: "allocation_stalls", Total: sumVMStat(v, "allocstall")},
+		{Name: "kswapd_scanned", Total: sumVMStat(v, "pgscan_kswapd")},
+		{Name: "kswapd_stolen", Total: sumVMStat(v, "pgsteal_kswapd")},
+		{Name: "oom_kills", Total: v["oom_kill"]},
+	}
+}
+
+// sumVMStat returns v[name], or on older kernels that split counters per
+// zone (e.g. pgscan_direct_normal), the sum of all name_<zone> counters
+func sumVMStat(v map[string]uint64, name string) uint64 {
+	if total, ok := v[name]; ok {
+		return total
+	}
+
+	total := uint64(0)
+	for key, value := range v {
+		if strings.HasPrefix(key, name+"_") && !strings.HasSuffix(key, "_throttle") {
+			total += value
+		}
+	}
+	return total
+}
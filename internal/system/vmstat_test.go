@@ -0,0 +1,84 @@
+package system
+
+import (
+	"testing"
+	"time"
+
+	"github.com/example/sysinfo-cli/internal/models"
+)
+
+func counterByName(counters []models.CounterRate, name string) models.CounterRate {
+	for _, c := range counters {
+		if c.Name == name {
+			return c
+		}
+	}
+	return models.CounterRate{}
+}
+
+func TestVMStatCounters(t *testing.T) {
+	data := `pgpgin 701474
+pgpgout 378372
+pswpin 12
+pswpout 34
+pgfault 6433092
+pgmajfault 335
+pgscan_direct 100
+pgscan_direct_throttle 7
+pgsteal_direct 90
+allocstall_dma32 2
+allocstall_normal 3
+pgscan_kswapd 500
+pgsteal_kswapd 450
+oom_kill 1
+`
+
+	counters := vmstatCounters(parseVMStat(data))
+
+	tests := []struct {
+		name     string
+		expected uint64
+	}{
+		{"page_faults_minor", 6433092 - 335},
+		{"page_faults_major", 335},
+		{"page_in_kb", 701474},
+		{"swap_out_pages", 34},
+		{"direct_reclaim_scanned", 100},
+		{"allocation_stalls", 5},
+		{"kswapd_stolen", 450},
+		{"oom_kills", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := counterByName(counters, tt.name).Total; got != tt.expected {
+				t.Errorf("%s = %d, want %d", tt.name, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSumVMStatPerZone(t *testing.T) {
+	// Kernels before 4.8 report reclaim counters per zone
+	v := parseVMStat("pgscan_direct_dma 1\npgscan_direct_normal 10\npgscan_direct_throttle 99\n")
+	if got := sumVMStat(v, "pgscan_direct"); got != 11 {
+		t.Errorf("sumVMStat = %d, want 11", got)
+	}
+}
+
+func TestApplyCounterRates(t *testing.T) {
+	prev := []models.CounterRate{{Name: "page_faults_major", Total: 100}, {Name: "oom_kills", Total: 5}}
+	cur := []models.CounterRate{{Name: "page_faults_major", Total: 300}, {Name: "oom_kills", Total: 2}, {Name: "new", Total: 1}}
+
+	applyCounterRates(cur, prev, 2*time.Second)
+
+	if cur[0].PerSecond == nil || *cur[0].PerSecond != 100 {
+		t.Errorf("Expected 100/s, got %v", cur[0].PerSecond)
+	}
+	if cur[1].PerSecond != nil {
+		t.Errorf("Expected no rate for counter reset, got %.2f", *cur[1].PerSecond)
+	}
+	if cur[2].PerSecond != nil {
+		t.Errorf("Expected no rate for counter without previous sample")
+	}
+}